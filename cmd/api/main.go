@@ -1,11 +1,23 @@
 package main
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
 
+	"github.com/FauzanParanditha/portfolio-backend/internal/analytics"
+	"github.com/FauzanParanditha/portfolio-backend/internal/cache"
+	"github.com/FauzanParanditha/portfolio-backend/internal/changes"
 	"github.com/FauzanParanditha/portfolio-backend/internal/config"
 	"github.com/FauzanParanditha/portfolio-backend/internal/db"
+	"github.com/FauzanParanditha/portfolio-backend/internal/linkcheck"
 	"github.com/FauzanParanditha/portfolio-backend/internal/logger"
+	"github.com/FauzanParanditha/portfolio-backend/internal/pagination"
+	"github.com/FauzanParanditha/portfolio-backend/internal/repository"
 	"github.com/joho/godotenv"
 	"github.com/rs/zerolog/log"
 
@@ -30,21 +42,102 @@ func main() {
 	// Init zerolog global logger
 	logger.Init(cfg.AppEnv)
 
+	// Secret untuk tanda tangan cursor pagination
+	pagination.Init(cfg.CursorSecret)
+
 	log.Info().
 		Str("env", cfg.AppEnv).
 		Msg("starting ppnd-backend")
 
 	gormDB := db.New(cfg)
 
+	// ctx selesai saat SIGINT / SIGTERM
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// worker background dihentikan setelah server berhenti terima request,
+	// supaya view dari request terakhir masih ikut di-flush
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+
+	var workers sync.WaitGroup
+	background := func(run func(context.Context)) {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			run(workerCtx)
+		}()
+	}
+
+	// Background link checker untuk URL project
+	if cfg.LinkCheckEnabled {
+		checker := linkcheck.New(repository.NewLinkCheckRepository(gormDB), linkcheck.Config{
+			Interval:    time.Duration(cfg.LinkCheckInterval) * time.Second,
+			Timeout:     time.Duration(cfg.LinkCheckTimeout) * time.Second,
+			Concurrency: cfg.LinkCheckConcurrency,
+		})
+		background(checker.Run)
+	}
+
+	// Recorder view project, flush ke rollup harian di background
+	var viewRecorder *analytics.Recorder
+	if cfg.AnalyticsEnabled {
+		viewRecorder = analytics.NewRecorder(repository.NewAnalyticsRepository(gormDB), analytics.Config{
+			BufferSize:    cfg.AnalyticsBufferSize,
+			FlushInterval: time.Duration(cfg.AnalyticsFlushInterval) * time.Second,
+			MaxVisitors:   cfg.AnalyticsMaxVisitors,
+			MaxReferrers:  cfg.AnalyticsMaxReferrers,
+		})
+		background(viewRecorder.Run)
+	}
+
+	// Waktu perubahan konten per topic disimpan di DB (Last-Modified & invalidasi antar instance)
+	changes.SetStore(repository.NewContentChangeStore(gormDB))
+	loadCtx, cancelLoad := context.WithTimeout(context.Background(), 5*time.Second)
+	if err := changes.Load(loadCtx); err != nil {
+		log.Error().Err(err).Msg("failed to load content changes")
+	}
+	cancelLoad()
+	background(func(ctx context.Context) {
+		changes.Watch(ctx, time.Duration(cfg.ChangesPollInterval)*time.Second)
+	})
+
+	// Cache repository publik, dibuang setiap admin commit perubahan
+	var repoCache *cache.Cache
+	if cfg.RepoCacheEnabled {
+		repoCache = cache.New(cache.Config{
+			TTL:        time.Duration(cfg.RepoCacheTTL) * time.Second,
+			MaxEntries: cfg.RepoCacheMaxEntries,
+		})
+		changes.Subscribe(repository.CacheInvalidator(repoCache))
+	}
+
 	app := httprouter.NewRouter(httprouter.AppDeps{
-		DB:     gormDB,
-		Config: cfg,
+		DB:           gormDB,
+		Config:       cfg,
+		ViewRecorder: viewRecorder,
+		Cache:        repoCache,
 	})
 
 	addr := fmt.Sprintf(":%s", cfg.AppPort)
 	log.Info().Str("addr", addr).Msg("server listening")
 
-	if err := app.Listen(addr); err != nil {
-		log.Fatal().Err(err).Msg("fiber server error")
+	go func() {
+		if err := app.Listen(addr); err != nil {
+			log.Fatal().Err(err).Msg("fiber server error")
+		}
+	}()
+
+	<-ctx.Done()
+	log.Info().Msg("shutting down")
+
+	if err := app.ShutdownWithTimeout(10 * time.Second); err != nil {
+		log.Error().Err(err).Msg("fiber shutdown error")
 	}
+
+	// tunggu worker selesai (termasuk flush terakhir analytics)
+	stopWorkers()
+	workers.Wait()
+
+	log.Info().Msg("server stopped")
 }
@@ -3,7 +3,9 @@ module github.com/FauzanParanditha/portfolio-backend
 go 1.24.5
 
 require (
+	github.com/go-pdf/fpdf v0.9.0
 	github.com/rs/zerolog v1.34.0
+	github.com/santhosh-tekuri/jsonschema/v5 v5.3.1
 	github.com/swaggo/swag v1.16.4
 	gorm.io/driver/postgres v1.6.0
 )
@@ -57,7 +59,7 @@ require (
 	github.com/joho/godotenv v1.5.1
 	github.com/lib/pq v1.10.9
 	golang.org/x/crypto v0.45.0
-	golang.org/x/sync v0.18.0 // indirect
+	golang.org/x/sync v0.18.0
 	golang.org/x/text v0.31.0 // indirect
 	gorm.io/datatypes v1.2.7
 	gorm.io/gorm v1.30.0
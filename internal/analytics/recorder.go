@@ -0,0 +1,331 @@
+package analytics
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/FauzanParanditha/portfolio-backend/internal/repository"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// View: satu kali project detail dibuka. IP & user-agent hanya dipakai
+// untuk hashing di memori, tidak pernah disimpan.
+type View struct {
+	ProjectID uuid.UUID
+	IP        string
+	UserAgent string
+	Referrer  string
+	At        time.Time
+}
+
+type Config struct {
+	BufferSize    int
+	FlushInterval time.Duration
+	MaxVisitors   int // batas hash visitor yang diingat per hari (dedupe uniques)
+	MaxReferrers  int // batas referrer host berbeda per project per hari, sisanya masuk "other"
+}
+
+// panjang kolom project_view_daily.referrer_host
+const maxReferrerHostLen = 255
+
+// rollup yang gagal disimpan sebanyak ini (per baris) dibuang
+const maxFlushAttempts = 3
+
+type rollupKey struct {
+	ProjectID    uuid.UUID
+	Day          string
+	ReferrerHost string
+	UAClass      string
+}
+
+// Recorder menampung view lewat channel (non-blocking), lalu
+// worker Run() mengagregasi dan flush ke rollup harian secara berkala.
+type Recorder struct {
+	repo repository.AnalyticsRepository
+	cfg  Config
+	ch   chan View
+
+	// state di bawah hanya disentuh oleh goroutine Run()
+	saltDay  string
+	salt     []byte
+	seen     map[string]struct{}
+	seenFull bool
+	hosts    map[uuid.UUID]map[string]struct{} // referrer host per project untuk saltDay
+	pending  map[rollupKey]*models.ProjectViewDaily
+	attempts map[rollupKey]int
+}
+
+func NewRecorder(repo repository.AnalyticsRepository, cfg Config) *Recorder {
+	if cfg.BufferSize < 1 {
+		cfg.BufferSize = 1024
+	}
+	if cfg.FlushInterval <= 0 {
+		cfg.FlushInterval = 30 * time.Second
+	}
+	if cfg.MaxVisitors < 1 {
+		cfg.MaxVisitors = 100000
+	}
+	if cfg.MaxReferrers < 1 {
+		cfg.MaxReferrers = 50
+	}
+
+	return &Recorder{
+		repo:     repo,
+		cfg:      cfg,
+		ch:       make(chan View, cfg.BufferSize),
+		seen:     map[string]struct{}{},
+		hosts:    map[uuid.UUID]map[string]struct{}{},
+		pending:  map[rollupKey]*models.ProjectViewDaily{},
+		attempts: map[rollupKey]int{},
+	}
+}
+
+// Record tidak pernah blocking: kalau buffer penuh, view di-drop.
+// Aman dipanggil pada Recorder nil (analytics dimatikan).
+func (r *Recorder) Record(v View) {
+	if r == nil {
+		return
+	}
+
+	select {
+	case r.ch <- v:
+	default:
+		log.Warn().Msg("analytics buffer full, dropping view")
+	}
+}
+
+// Run memproses view sampai ctx selesai, lalu flush sisa data.
+func (r *Recorder) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.cfg.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case v := <-r.ch:
+			r.add(v)
+		case <-ticker.C:
+			r.flush(ctx)
+		case <-ctx.Done():
+			r.drain()
+			r.flush(context.Background())
+			return
+		}
+	}
+}
+
+// drain: ambil view yang masih antre di channel sebelum flush terakhir
+func (r *Recorder) drain() {
+	for {
+		select {
+		case v := <-r.ch:
+			r.add(v)
+		default:
+			return
+		}
+	}
+}
+
+func (r *Recorder) add(v View) {
+	class := UAClass(v.UserAgent)
+	if class == "bot" {
+		return
+	}
+
+	at := v.At.UTC()
+	day := at.Format("2006-01-02")
+	r.rotateSalt(day)
+
+	key := rollupKey{
+		ProjectID:    v.ProjectID,
+		Day:          day,
+		ReferrerHost: r.referrerBucket(v.ProjectID, ReferrerHost(v.Referrer)),
+		UAClass:      class,
+	}
+
+	row, ok := r.pending[key]
+	if !ok {
+		d, _ := time.Parse("2006-01-02", day)
+		row = &models.ProjectViewDaily{
+			ProjectID:    key.ProjectID,
+			Day:          d,
+			ReferrerHost: key.ReferrerHost,
+			UAClass:      key.UAClass,
+		}
+		r.pending[key] = row
+	}
+
+	row.Views++
+
+	visitor := r.visitorHash(v)
+	if _, dup := r.seen[visitor]; dup {
+		return
+	}
+
+	// memori dibatasi: setelah penuh, visitor baru tetap dihitung unique
+	// tapi tidak diingat (uniques bisa sedikit lebih tinggi sampai ganti hari)
+	if len(r.seen) < r.cfg.MaxVisitors {
+		r.seen[visitor] = struct{}{}
+	} else if !r.seenFull {
+		r.seenFull = true
+		log.Warn().Int("max", r.cfg.MaxVisitors).Msg("analytics visitor set full, uniques may be overcounted today")
+	}
+	row.Uniques++
+}
+
+// rotateSalt: salt baru setiap ganti hari (UTC), hash hari sebelumnya dibuang
+func (r *Recorder) rotateSalt(day string) {
+	if r.saltDay == day {
+		return
+	}
+
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		log.Error().Err(err).Msg("failed to generate analytics salt")
+	}
+
+	r.saltDay = day
+	r.salt = salt
+	r.seen = map[string]struct{}{}
+	r.seenFull = false
+	r.hosts = map[uuid.UUID]map[string]struct{}{}
+}
+
+// referrerBucket: referrer host dikontrol client, jadi jumlah host berbeda per
+// project per hari dibatasi; host baru setelah batas tercapai dihitung "other"
+func (r *Recorder) referrerBucket(projectID uuid.UUID, host string) string {
+	if host == "direct" || host == "other" {
+		return host
+	}
+
+	hosts, ok := r.hosts[projectID]
+	if !ok {
+		hosts = map[string]struct{}{}
+		r.hosts[projectID] = hosts
+	}
+
+	if _, ok := hosts[host]; ok {
+		return host
+	}
+	if len(hosts) >= r.cfg.MaxReferrers {
+		return "other"
+	}
+
+	hosts[host] = struct{}{}
+	return host
+}
+
+func (r *Recorder) visitorHash(v View) string {
+	h := sha256.New()
+	h.Write(r.salt)
+	h.Write([]byte(v.ProjectID.String()))
+	h.Write([]byte{0})
+	h.Write([]byte(v.IP))
+	h.Write([]byte{0})
+	h.Write([]byte(v.UserAgent))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (r *Recorder) flush(ctx context.Context) {
+	if len(r.pending) == 0 {
+		return
+	}
+
+	rows := make([]models.ProjectViewDaily, 0, len(r.pending))
+	for _, row := range r.pending {
+		rows = append(rows, *row)
+	}
+
+	batchCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	err := r.repo.IncrementDaily(batchCtx, rows)
+	cancel()
+
+	if err == nil {
+		r.pending = map[rollupKey]*models.ProjectViewDaily{}
+		r.attempts = map[rollupKey]int{}
+		return
+	}
+	log.Error().Err(err).Int("rows", len(rows)).Msg("failed to flush project views, retrying per row")
+
+	// satu baris rusak tidak boleh memblokir seluruh batch: simpan satu per satu,
+	// baris yang gagal dicoba lagi di flush berikutnya sampai maxFlushAttempts
+	rowCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	for key, row := range r.pending {
+		if rowCtx.Err() != nil {
+			// DB tidak menjawab; sisa baris tetap pending tanpa dihitung gagal
+			return
+		}
+
+		err := r.repo.IncrementDaily(rowCtx, []models.ProjectViewDaily{*row})
+		if err == nil {
+			delete(r.pending, key)
+			delete(r.attempts, key)
+			continue
+		}
+
+		r.attempts[key]++
+		if r.attempts[key] >= maxFlushAttempts {
+			log.Error().
+				Err(err).
+				Str("project_id", key.ProjectID.String()).
+				Str("day", key.Day).
+				Str("referrer_host", key.ReferrerHost).
+				Int64("views", row.Views).
+				Msg("dropping project view rollup after repeated flush failures")
+
+			delete(r.pending, key)
+			delete(r.attempts, key)
+		}
+	}
+}
+
+// ReferrerHost: ambil host dari header Referer, tanpa path/query
+func ReferrerHost(ref string) string {
+	if ref == "" {
+		return "direct"
+	}
+
+	u, err := url.Parse(ref)
+	if err != nil || u.Hostname() == "" {
+		return "direct"
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	if len(host) > maxReferrerHostLen {
+		return "other"
+	}
+
+	return host
+}
+
+// UAClass: klasifikasi kasar user-agent → bot | mobile | tablet | desktop | other
+func UAClass(ua string) string {
+	s := strings.ToLower(ua)
+
+	switch {
+	case s == "":
+		return "other"
+	case strings.Contains(s, "bot"), strings.Contains(s, "crawl"),
+		strings.Contains(s, "spider"), strings.Contains(s, "slurp"),
+		strings.Contains(s, "curl"), strings.Contains(s, "wget"),
+		strings.Contains(s, "headless"):
+		return "bot"
+	case strings.Contains(s, "ipad"), strings.Contains(s, "tablet"):
+		return "tablet"
+	case strings.Contains(s, "mobi"), strings.Contains(s, "iphone"), strings.Contains(s, "android"):
+		return "mobile"
+	case strings.Contains(s, "windows"), strings.Contains(s, "macintosh"),
+		strings.Contains(s, "x11"), strings.Contains(s, "linux"), strings.Contains(s, "cros"):
+		return "desktop"
+	default:
+		return "other"
+	}
+}
@@ -0,0 +1,193 @@
+package cache
+
+import (
+	"container/list"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+type Config struct {
+	TTL        time.Duration
+	MaxEntries int
+}
+
+// Stats: counter sejak proses start
+type Stats struct {
+	Entries       int    `json:"entries"`
+	MaxEntries    int    `json:"maxEntries"`
+	TTLSeconds    int    `json:"ttlSeconds"`
+	Hits          uint64 `json:"hits"`
+	Misses        uint64 `json:"misses"`
+	Evictions     uint64 `json:"evictions"`
+	Invalidations uint64 `json:"invalidations"`
+}
+
+type entry struct {
+	key     string
+	value   any
+	expires time.Time
+}
+
+// Cache: LRU in-memory dengan TTL per entry + singleflight untuk load yang miss.
+// Semua method aman dipanggil pada Cache nil (cache dimatikan → selalu load).
+type Cache struct {
+	cfg Config
+
+	mu    sync.Mutex
+	ll    *list.List // depan = paling baru dipakai
+	items map[string]*list.Element
+	gen   uint64 // naik setiap invalidasi, supaya hasil load lama tidak disimpan
+
+	group singleflight.Group
+
+	hits, misses, evictions, invalidations atomic.Uint64
+}
+
+func New(cfg Config) *Cache {
+	if cfg.TTL <= 0 {
+		cfg.TTL = 5 * time.Minute
+	}
+	if cfg.MaxEntries < 1 {
+		cfg.MaxEntries = 1000
+	}
+
+	return &Cache{
+		cfg:   cfg,
+		ll:    list.New(),
+		items: map[string]*list.Element{},
+	}
+}
+
+// Fetch: ambil dari cache, atau panggil load (sekali untuk semua request
+// bersamaan dengan key yang sama) lalu simpan hasilnya. Error tidak di-cache.
+func Fetch[T any](c *Cache, key string, load func() (T, error)) (T, error) {
+	if c == nil {
+		return load()
+	}
+
+	if v, ok := c.get(key); ok {
+		c.hits.Add(1)
+		return v.(T), nil
+	}
+	c.misses.Add(1)
+
+	gen := c.generation()
+
+	v, err, _ := c.group.Do(strconv.FormatUint(gen, 10)+"|"+key, func() (any, error) {
+		v, err := load()
+		if err != nil {
+			return nil, err
+		}
+		c.set(key, v, gen)
+		return v, nil
+	})
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	return v.(T), nil
+}
+
+func (c *Cache) generation() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.gen
+}
+
+func (c *Cache) get(key string) (any, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+
+	e := el.Value.(*entry)
+	if time.Now().After(e.expires) {
+		c.ll.Remove(el)
+		delete(c.items, key)
+		return nil, false
+	}
+
+	c.ll.MoveToFront(el)
+	return e.value, true
+}
+
+func (c *Cache) set(key string, value any, gen uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// ada invalidasi selama load → hasilnya mungkin sudah basi
+	if gen != c.gen {
+		return
+	}
+
+	expires := time.Now().Add(c.cfg.TTL)
+
+	if el, ok := c.items[key]; ok {
+		el.Value = &entry{key: key, value: value, expires: expires}
+		c.ll.MoveToFront(el)
+		return
+	}
+
+	c.items[key] = c.ll.PushFront(&entry{key: key, value: value, expires: expires})
+
+	for c.ll.Len() > c.cfg.MaxEntries {
+		oldest := c.ll.Back()
+		c.ll.Remove(oldest)
+		delete(c.items, oldest.Value.(*entry).key)
+		c.evictions.Add(1)
+	}
+}
+
+// DeletePrefix membuang semua entry dengan prefix key tertentu (mis. "projects:")
+func (c *Cache) DeletePrefix(prefix string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.gen++
+	for key, el := range c.items {
+		if strings.HasPrefix(key, prefix) {
+			c.ll.Remove(el)
+			delete(c.items, key)
+		}
+	}
+	c.invalidations.Add(1)
+}
+
+// Clear membuang semua entry
+func (c *Cache) Clear() {
+	c.DeletePrefix("")
+}
+
+func (c *Cache) Stats() Stats {
+	if c == nil {
+		return Stats{}
+	}
+
+	c.mu.Lock()
+	n := c.ll.Len()
+	c.mu.Unlock()
+
+	return Stats{
+		Entries:       n,
+		MaxEntries:    c.cfg.MaxEntries,
+		TTLSeconds:    int(c.cfg.TTL / time.Second),
+		Hits:          c.hits.Load(),
+		Misses:        c.misses.Load(),
+		Evictions:     c.evictions.Load(),
+		Invalidations: c.invalidations.Load(),
+	}
+}
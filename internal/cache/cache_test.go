@@ -0,0 +1,143 @@
+package cache
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// counter: load yang menghitung berapa kali dipanggil
+type counter struct{ calls int }
+
+func (c *counter) load(v string) func() (string, error) {
+	return func() (string, error) {
+		c.calls++
+		return v, nil
+	}
+}
+
+func TestFetchCachesUntilTTL(t *testing.T) {
+	c := New(Config{TTL: 30 * time.Millisecond, MaxEntries: 10})
+	var n counter
+
+	for i := 0; i < 3; i++ {
+		if v, _ := Fetch(c, "k", n.load("v")); v != "v" {
+			t.Fatalf("Fetch = %q, want v", v)
+		}
+	}
+	if n.calls != 1 {
+		t.Fatalf("load called %d times before TTL, want 1", n.calls)
+	}
+
+	time.Sleep(40 * time.Millisecond)
+
+	Fetch(c, "k", n.load("v"))
+	if n.calls != 2 {
+		t.Fatalf("load called %d times after TTL, want 2", n.calls)
+	}
+}
+
+func TestFetchEvictsLeastRecentlyUsed(t *testing.T) {
+	c := New(Config{TTL: time.Minute, MaxEntries: 2})
+	var n counter
+
+	Fetch(c, "a", n.load("a"))
+	Fetch(c, "b", n.load("b"))
+	Fetch(c, "a", n.load("a")) // a jadi paling baru
+	Fetch(c, "c", n.load("c")) // b yang dibuang
+
+	tests := []struct {
+		key    string
+		cached bool
+	}{
+		{"a", true},
+		{"b", false},
+		{"c", true},
+	}
+	for _, tt := range tests {
+		if _, ok := c.get(tt.key); ok != tt.cached {
+			t.Errorf("key %q cached = %v, want %v", tt.key, ok, tt.cached)
+		}
+	}
+
+	if s := c.Stats(); s.Entries != 2 || s.Evictions != 1 {
+		t.Fatalf("stats = %+v, want 2 entries and 1 eviction", s)
+	}
+}
+
+func TestDeletePrefix(t *testing.T) {
+	c := New(Config{TTL: time.Minute, MaxEntries: 10})
+	var n counter
+
+	for _, k := range []string{"projects:list", "projects:slug:a", "posts:list"} {
+		Fetch(c, k, n.load(k))
+	}
+
+	c.DeletePrefix("projects:")
+
+	tests := []struct {
+		key    string
+		cached bool
+	}{
+		{"projects:list", false},
+		{"projects:slug:a", false},
+		{"posts:list", true},
+	}
+	for _, tt := range tests {
+		if _, ok := c.get(tt.key); ok != tt.cached {
+			t.Errorf("key %q cached = %v, want %v", tt.key, ok, tt.cached)
+		}
+	}
+
+	c.Clear()
+	if s := c.Stats(); s.Entries != 0 || s.Invalidations != 2 {
+		t.Fatalf("stats = %+v, want 0 entries and 2 invalidations", s)
+	}
+}
+
+func TestFetchDropsResultLoadedAcrossInvalidation(t *testing.T) {
+	c := New(Config{TTL: time.Minute, MaxEntries: 10})
+
+	// admin commit terjadi saat load masih jalan → hasil load basi, tidak disimpan
+	v, err := Fetch(c, "projects:list", func() (string, error) {
+		c.DeletePrefix("projects:")
+		return "stale", nil
+	})
+	if err != nil || v != "stale" {
+		t.Fatalf("Fetch = %q, %v", v, err)
+	}
+
+	if _, ok := c.get("projects:list"); ok {
+		t.Fatal("value loaded before invalidation was cached")
+	}
+}
+
+func TestFetchDoesNotCacheErrors(t *testing.T) {
+	c := New(Config{TTL: time.Minute, MaxEntries: 10})
+	boom := errors.New("db down")
+
+	if _, err := Fetch(c, "k", func() (string, error) { return "", boom }); !errors.Is(err, boom) {
+		t.Fatalf("err = %v, want %v", err, boom)
+	}
+
+	var n counter
+	if v, err := Fetch(c, "k", n.load("ok")); err != nil || v != "ok" || n.calls != 1 {
+		t.Fatalf("Fetch after error = %q, %v (calls %d), want fresh load", v, err, n.calls)
+	}
+}
+
+func TestNilCacheAlwaysLoads(t *testing.T) {
+	var c *Cache
+	var n counter
+
+	Fetch(c, "k", n.load("v"))
+	Fetch(c, "k", n.load("v"))
+	c.DeletePrefix("k")
+
+	if n.calls != 2 {
+		t.Fatalf("load called %d times, want 2", n.calls)
+	}
+	if s := c.Stats(); s != (Stats{}) {
+		t.Fatalf("Stats on nil cache = %+v, want zero", s)
+	}
+}
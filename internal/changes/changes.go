@@ -0,0 +1,181 @@
+package changes
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Topic: kelompok konten publik yang bisa berubah lewat admin
+type Topic string
+
+const (
+	Projects    Topic = "projects"
+	Experiences Topic = "experiences"
+	Tags        Topic = "tags"
+	Posts       Topic = "posts"
+	Skills      Topic = "skills" // override skills matrix
+
+	Education      Topic = "education"
+	Certifications Topic = "certifications"
+	Awards         Topic = "awards"
+)
+
+// Store: waktu perubahan terakhir per topic di DB (tabel content_changes), jadi sama
+// untuk semua instance dan tidak hilang saat restart. Dipakai untuk Last-Modified
+// (termasuk delete yang tidak meninggalkan updated_at) & invalidasi cache antar instance.
+type Store interface {
+	Touch(ctx context.Context, topics []Topic, at time.Time) error
+	All(ctx context.Context) (map[Topic]time.Time, error)
+}
+
+// batas waktu query store dari Notify
+const storeTimeout = 2 * time.Second
+
+var (
+	mu    sync.RWMutex
+	store Store
+	last  = map[Topic]time.Time{} // salinan store di memori, di-refresh oleh Load / Watch
+	subs  []func(Topic)
+)
+
+// SetStore dipanggil sekali saat startup
+func SetStore(s Store) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	store = s
+}
+
+// Notify dipanggil setelah admin write berhasil di-commit.
+// Mencatat waktu perubahan topic tsb & memanggil semua subscriber lokal.
+// Instance lain menyusul lewat Watch.
+func Notify(topics ...Topic) {
+	now := time.Now()
+
+	mu.Lock()
+	for _, t := range topics {
+		last[t] = now
+	}
+	s := store
+	fns := append([]func(Topic){}, subs...)
+	mu.Unlock()
+
+	if s != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), storeTimeout)
+		if err := s.Touch(ctx, topics, now); err != nil {
+			log.Error().Err(err).Msg("failed to record content change")
+		}
+		cancel()
+	}
+
+	for _, t := range topics {
+		for _, fn := range fns {
+			fn(t)
+		}
+	}
+}
+
+// LastModified: waktu perubahan terakhir dari topic-topic yang diminta.
+// Dibaca dari memori saja (dipanggil di setiap GET publik); perubahan dari
+// instance lain masuk lewat Watch. Zero time kalau belum pernah ada perubahan tercatat.
+func LastModified(topics ...Topic) time.Time {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	var latest time.Time
+	for _, t := range topics {
+		if ts := last[t]; ts.After(latest) {
+			latest = ts
+		}
+	}
+	return latest
+}
+
+// Load mengisi waktu perubahan dari store, dipanggil sekali saat startup sebelum
+// server menerima request
+func Load(ctx context.Context) error {
+	mu.RLock()
+	s := store
+	mu.RUnlock()
+	if s == nil {
+		return nil
+	}
+
+	cur, err := s.All(ctx)
+	if err != nil {
+		return err
+	}
+
+	merge(cur)
+	return nil
+}
+
+// merge: ambil waktu yang lebih baru per topic, kembalikan topic yang berubah
+func merge(cur map[Topic]time.Time) []Topic {
+	mu.Lock()
+	defer mu.Unlock()
+
+	var changed []Topic
+	for t, ts := range cur {
+		if !ts.After(last[t]) {
+			continue
+		}
+		last[t] = ts
+		changed = append(changed, t)
+	}
+	return changed
+}
+
+// Subscribe mendaftarkan callback yang dipanggil setiap Notify (mis. invalidasi cache)
+func Subscribe(fn func(Topic)) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	subs = append(subs, fn)
+}
+
+// Watch: poll store setiap interval, perbarui LastModified dan panggil subscriber
+// untuk topic yang diubah instance lain. Berhenti saat ctx selesai.
+func Watch(ctx context.Context, interval time.Duration) {
+	mu.RLock()
+	s := store
+	mu.RUnlock()
+	if s == nil || interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		cur, err := s.All(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("failed to poll content changes")
+			continue
+		}
+
+		changed := merge(cur)
+		if len(changed) == 0 {
+			continue
+		}
+
+		mu.RLock()
+		fns := append([]func(Topic){}, subs...)
+		mu.RUnlock()
+
+		for _, t := range changed {
+			for _, fn := range fns {
+				fn(t)
+			}
+		}
+	}
+}
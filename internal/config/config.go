@@ -1,6 +1,11 @@
 package config
 
 import (
+	"crypto/hkdf"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
 	"github.com/FauzanParanditha/portfolio-backend/internal/helpers"
 )
 
@@ -13,14 +18,96 @@ type Config struct {
 	JWTSecret    string
 	JWTExpiresIn int
 
+	// Secret HMAC untuk cursor pagination (default: diturunkan dari JWT secret)
+	CursorSecret string
+
+	// Info situs publik (frontend), untuk link absolute di feed / sitemap
+	SiteBaseURL     string
+	SiteTitle       string
+	SiteDescription string
+	SiteAuthor      string
+	SiteLanguage    string
+
+	// Path halaman statis frontend untuk sitemap, dipisah koma
+	SitemapStaticPages string
+	// true = robots.txt "Disallow: /" (staging / preview)
+	RobotsDisallowAll bool
+
+	// basics JSON Resume (belum ada tabel profile)
+	ResumeName        string
+	ResumeLabel       string
+	ResumeEmail       string
+	ResumePhone       string
+	ResumeImage       string
+	ResumeSummary     string
+	ResumeCity        string
+	ResumeCountryCode string
+	// "GitHub=https://github.com/x,LinkedIn=https://linkedin.com/in/x"
+	ResumeProfiles string
+
+	// Render PDF (resume.pdf & case-study.pdf)
+	PDFPaperSize         string // A4 / Letter / Legal
+	PDFResumeTemplate    string // classic / compact / serif
+	PDFCaseStudyTemplate string
+	PDFCacheMaxEntries   int
+	PDFCacheTTL          int  // detik
+	PDFFetchImages       bool // false = screenshot ditulis sebagai URL
+	PDFImageMaxBytes     int
+
 	CORSAllowedOrigins string
 	CORSAllowedMethods string
 	CORSAllowedHeaders string
 	CORSAllowCredentials bool
+
+	// Di belakang CDN / reverse proxy: header berisi IP client asli (mis. CF-Connecting-IP,
+	// X-Real-IP). Header hanya dipercaya dari IP/CIDR di TrustedProxies (dipisah koma);
+	// TrustedProxies kosong = header diabaikan.
+	ProxyHeader    string
+	TrustedProxies string
+
+	// Link checker (demo/repo/cover/screenshot URL project)
+	LinkCheckEnabled     bool
+	LinkCheckInterval    int // detik
+	LinkCheckTimeout     int // detik, per request
+	LinkCheckConcurrency int
+
+	// Analytics view project (tanpa cookie)
+	AnalyticsEnabled       bool
+	AnalyticsBufferSize    int
+	AnalyticsFlushInterval int // detik
+	AnalyticsMaxVisitors   int // batas hash visitor per hari di memori
+	AnalyticsMaxReferrers  int // batas referrer host per project per hari
+
+	// Cache-Control endpoint publik (detik)
+	CacheProjectsMaxAge      int
+	CacheProjectsSWR         int
+	CacheProjectDetailMaxAge int
+	CacheProjectDetailSWR    int
+	CacheExperiencesMaxAge   int
+	CacheExperiencesSWR      int
+	CachePostsMaxAge         int
+	CachePostsSWR            int
+	CacheFeedsMaxAge         int
+	CacheFeedsSWR            int
+	CacheSitemapMaxAge       int
+	CacheSitemapSWR          int
+	CacheSkillsMaxAge        int
+	CacheSkillsSWR           int
+
+	// Cache in-memory di depan repository publik
+	RepoCacheEnabled    bool
+	RepoCacheTTL        int // detik
+	RepoCacheMaxEntries int
+
+	// Poll tabel content_changes untuk invalidasi cache dari instance lain
+	ChangesPollInterval int // detik, 0 = mati
+
+	// Content health: ID rule yang dimatikan, dipisah koma
+	ContentHealthDisabledRules string
 }
 
 func Load() *Config {
-	return &Config{
+	cfg := &Config{
 		AppEnv: helpers.GetEnv("APP_ENV", "development"),
 
 		AppPort: helpers.GetEnv("APP_PORT", "8080"),
@@ -29,9 +116,84 @@ func Load() *Config {
 		JWTSecret:    helpers.GetEnv("JWT_SECRET", "super-secret-ganti-sendiri"),
 		JWTExpiresIn: helpers.GetEnvInt("JWT_EXPIRES_IN", 1800),
 
+		SiteBaseURL:     strings.TrimRight(helpers.GetEnv("SITE_BASE_URL", "http://localhost:3000"), "/"),
+		SiteTitle:       helpers.GetEnv("SITE_TITLE", "Portfolio"),
+		SiteDescription: helpers.GetEnv("SITE_DESCRIPTION", ""),
+		SiteAuthor:      helpers.GetEnv("SITE_AUTHOR", ""),
+		SiteLanguage:    helpers.GetEnv("SITE_LANGUAGE", "en"),
+
+		SitemapStaticPages: helpers.GetEnv("SITEMAP_STATIC_PAGES", "/,/projects,/experiences,/posts"),
+		RobotsDisallowAll:  helpers.GetEnvBool("ROBOTS_DISALLOW_ALL", false),
+
+		ResumeName:        helpers.GetEnv("RESUME_NAME", helpers.GetEnv("SITE_AUTHOR", "")),
+		ResumeLabel:       helpers.GetEnv("RESUME_LABEL", ""),
+		ResumeEmail:       helpers.GetEnv("RESUME_EMAIL", ""),
+		ResumePhone:       helpers.GetEnv("RESUME_PHONE", ""),
+		ResumeImage:       helpers.GetEnv("RESUME_IMAGE", ""),
+		ResumeSummary:     helpers.GetEnv("RESUME_SUMMARY", ""),
+		ResumeCity:        helpers.GetEnv("RESUME_CITY", ""),
+		ResumeCountryCode: helpers.GetEnv("RESUME_COUNTRY_CODE", ""),
+		ResumeProfiles:    helpers.GetEnv("RESUME_PROFILES", ""),
+
+		PDFPaperSize:         helpers.GetEnv("PDF_PAPER_SIZE", "A4"),
+		PDFResumeTemplate:    helpers.GetEnv("PDF_RESUME_TEMPLATE", "classic"),
+		PDFCaseStudyTemplate: helpers.GetEnv("PDF_CASE_STUDY_TEMPLATE", "classic"),
+		PDFCacheMaxEntries:   helpers.GetEnvInt("PDF_CACHE_MAX_ENTRIES", 32),
+		PDFCacheTTL:          helpers.GetEnvInt("PDF_CACHE_TTL", 86400),
+		PDFFetchImages:       helpers.GetEnvBool("PDF_FETCH_IMAGES", false),
+		PDFImageMaxBytes:     helpers.GetEnvInt("PDF_IMAGE_MAX_BYTES", 5<<20),
+
 		CORSAllowedOrigins: helpers.GetEnv("CORS_ALLOWED_ORIGINS", "*"),
 		CORSAllowedMethods: helpers.GetEnv("CORS_ALLOWED_METHODS", "GET,POST,PUT,PATCH,DELETE,OPTIONS"),
 		CORSAllowedHeaders: helpers.GetEnv("CORS_ALLOWED_HEADERS", "Origin, Content-Type, Accept, Authorization"),
 		CORSAllowCredentials: helpers.GetEnvBool("CORS_ALLOW_CREDENTIALS", false),
+
+		ProxyHeader:    helpers.GetEnv("PROXY_HEADER", ""),
+		TrustedProxies: helpers.GetEnv("TRUSTED_PROXIES", ""),
+
+		LinkCheckEnabled:     helpers.GetEnvBool("LINK_CHECK_ENABLED", false),
+		LinkCheckInterval:    helpers.GetEnvInt("LINK_CHECK_INTERVAL", 21600),
+		LinkCheckTimeout:     helpers.GetEnvInt("LINK_CHECK_TIMEOUT", 10),
+		LinkCheckConcurrency: helpers.GetEnvInt("LINK_CHECK_CONCURRENCY", 4),
+
+		AnalyticsEnabled:       helpers.GetEnvBool("ANALYTICS_ENABLED", true),
+		AnalyticsBufferSize:    helpers.GetEnvInt("ANALYTICS_BUFFER_SIZE", 1024),
+		AnalyticsFlushInterval: helpers.GetEnvInt("ANALYTICS_FLUSH_INTERVAL", 30),
+		AnalyticsMaxVisitors:   helpers.GetEnvInt("ANALYTICS_MAX_VISITORS", 100000),
+		AnalyticsMaxReferrers:  helpers.GetEnvInt("ANALYTICS_MAX_REFERRERS", 50),
+
+		CacheProjectsMaxAge:      helpers.GetEnvInt("CACHE_PROJECTS_MAX_AGE", 60),
+		CacheProjectsSWR:         helpers.GetEnvInt("CACHE_PROJECTS_SWR", 300),
+		CacheProjectDetailMaxAge: helpers.GetEnvInt("CACHE_PROJECT_DETAIL_MAX_AGE", 60),
+		CacheProjectDetailSWR:    helpers.GetEnvInt("CACHE_PROJECT_DETAIL_SWR", 300),
+		CacheExperiencesMaxAge:   helpers.GetEnvInt("CACHE_EXPERIENCES_MAX_AGE", 300),
+		CacheExperiencesSWR:      helpers.GetEnvInt("CACHE_EXPERIENCES_SWR", 3600),
+		CachePostsMaxAge:         helpers.GetEnvInt("CACHE_POSTS_MAX_AGE", 60),
+		CachePostsSWR:            helpers.GetEnvInt("CACHE_POSTS_SWR", 300),
+		CacheFeedsMaxAge:         helpers.GetEnvInt("CACHE_FEEDS_MAX_AGE", 900),
+		CacheFeedsSWR:            helpers.GetEnvInt("CACHE_FEEDS_SWR", 3600),
+		CacheSitemapMaxAge:       helpers.GetEnvInt("CACHE_SITEMAP_MAX_AGE", 3600),
+		CacheSitemapSWR:          helpers.GetEnvInt("CACHE_SITEMAP_SWR", 86400),
+		CacheSkillsMaxAge:        helpers.GetEnvInt("CACHE_SKILLS_MAX_AGE", 300),
+		CacheSkillsSWR:           helpers.GetEnvInt("CACHE_SKILLS_SWR", 3600),
+
+		RepoCacheEnabled:    helpers.GetEnvBool("REPO_CACHE_ENABLED", true),
+		RepoCacheTTL:        helpers.GetEnvInt("REPO_CACHE_TTL", 300),
+		RepoCacheMaxEntries: helpers.GetEnvInt("REPO_CACHE_MAX_ENTRIES", 1000),
+
+		ChangesPollInterval: helpers.GetEnvInt("CHANGES_POLL_INTERVAL", 10),
+
+		ContentHealthDisabledRules: helpers.GetEnv("CONTENT_HEALTH_DISABLED_RULES", ""),
 	}
+
+	// Tanpa CURSOR_SECRET: turunkan key sendiri dari JWT_SECRET (HKDF), jangan pakai
+	// secret JWT langsung untuk tanda tangan cursor
+	cfg.CursorSecret = helpers.GetEnv("CURSOR_SECRET", "")
+	if cfg.CursorSecret == "" {
+		// error hanya kalau panjang key > 255 * 32 byte
+		key, _ := hkdf.Key(sha256.New, []byte(cfg.JWTSecret), nil, "portfolio-backend cursor v1", 32)
+		cfg.CursorSecret = hex.EncodeToString(key)
+	}
+
+	return cfg
 }
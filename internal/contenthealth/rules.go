@@ -0,0 +1,209 @@
+package contenthealth
+
+import (
+	"context"
+	"strings"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/repository"
+	"github.com/google/uuid"
+)
+
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityInfo    Severity = "info"
+)
+
+// Rule: satu lint konten. Query harus menghasilkan kolom id & label.
+type Rule struct {
+	ID       string
+	Entity   string // project | experience | tag
+	Severity Severity
+	Message  string
+	Fix      string
+	Query    string
+}
+
+// Rules: semua rule bawaan, urutan = urutan tampil di report
+var Rules = []Rule{
+	{
+		ID:       "project-missing-long-description",
+		Entity:   "project",
+		Severity: SeverityWarning,
+		Message:  "Project has no long description",
+		Fix:      "Write the longDescription (problem, approach, outcome)",
+		Query:    `SELECT id, title AS label FROM projects WHERE COALESCE(TRIM(long_desc), '') = ''`,
+	},
+	{
+		ID:       "project-missing-screenshots",
+		Entity:   "project",
+		Severity: SeverityWarning,
+		Message:  "Project has no screenshots",
+		Fix:      "Upload at least one screenshot",
+		Query: `SELECT p.id, p.title AS label FROM projects p
+			WHERE NOT EXISTS (SELECT 1 FROM project_screenshots s WHERE s.project_id = p.id)`,
+	},
+	{
+		ID:       "project-missing-tags",
+		Entity:   "project",
+		Severity: SeverityWarning,
+		Message:  "Project has no tags",
+		Fix:      "Attach the tech stack tags used in the project",
+		Query: `SELECT p.id, p.title AS label FROM projects p
+			WHERE NOT EXISTS (SELECT 1 FROM project_tags pt WHERE pt.project_id = p.id)`,
+	},
+	{
+		ID:       "project-missing-links",
+		Entity:   "project",
+		Severity: SeverityInfo,
+		Message:  "Project has neither a demo URL nor a repository URL",
+		Fix:      "Add a demoUrl or repoUrl if the project is public",
+		Query: `SELECT id, title AS label FROM projects
+			WHERE COALESCE(demo_url, '') = '' AND COALESCE(repo_url, '') = ''`,
+	},
+	{
+		ID:       "experience-current-with-end-date",
+		Entity:   "experience",
+		Severity: SeverityError,
+		Message:  "Experience is marked current but has an end date",
+		Fix:      "Clear endDate or set isCurrent to false",
+		Query: `SELECT id, title || ' @ ' || company AS label FROM experiences
+			WHERE is_current AND end_date IS NOT NULL`,
+	},
+	{
+		ID:       "experience-past-missing-end-date",
+		Entity:   "experience",
+		Severity: SeverityError,
+		Message:  "Experience is not current but has no end date",
+		Fix:      "Set endDate or set isCurrent to true",
+		Query: `SELECT id, title || ' @ ' || company AS label FROM experiences
+			WHERE NOT is_current AND end_date IS NULL`,
+	},
+	{
+		ID:       "experience-end-before-start",
+		Entity:   "experience",
+		Severity: SeverityError,
+		Message:  "Experience ends before it starts",
+		Fix:      "Fix startDate / endDate",
+		Query: `SELECT id, title || ' @ ' || company AS label FROM experiences
+			WHERE end_date IS NOT NULL AND end_date < start_date`,
+	},
+	{
+		ID:       "experience-missing-highlights",
+		Entity:   "experience",
+		Severity: SeverityInfo,
+		Message:  "Experience has no highlights",
+		Fix:      "Add a few highlight bullets",
+		Query: `SELECT e.id, e.title || ' @ ' || e.company AS label FROM experiences e
+			WHERE NOT EXISTS (SELECT 1 FROM experience_highlights h WHERE h.experience_id = e.id)`,
+	},
+	{
+		ID:       "tag-unused",
+		Entity:   "tag",
+		Severity: SeverityInfo,
+		Message:  "Tag is not used by any content",
+		Fix:      "Attach it somewhere or delete it",
+		Query: `SELECT t.id, t.name AS label FROM tags t
+			WHERE NOT EXISTS (SELECT 1 FROM project_tags pt WHERE pt.tag_id = t.id)
+			AND NOT EXISTS (SELECT 1 FROM experience_tags et WHERE et.tag_id = t.id)
+			AND NOT EXISTS (SELECT 1 FROM post_tags pst WHERE pst.tag_id = t.id)
+			AND NOT EXISTS (SELECT 1 FROM education_tags edt WHERE edt.tag_id = t.id)
+			AND NOT EXISTS (SELECT 1 FROM certification_tags ct WHERE ct.tag_id = t.id)
+			AND NOT EXISTS (SELECT 1 FROM award_tags awt WHERE awt.tag_id = t.id)`,
+	},
+}
+
+// Finding: hasil lint per entity
+type Finding struct {
+	Rule     string   `json:"rule"`
+	Severity Severity `json:"severity"`
+	Message  string   `json:"message"`
+	Fix      string   `json:"fix"`
+	Entity   Entity   `json:"entity"`
+}
+
+type Entity struct {
+	Type  string    `json:"type"`
+	ID    uuid.UUID `json:"id"`
+	Label string    `json:"label"`
+	Link  string    `json:"link"` // endpoint admin untuk edit entity
+}
+
+var entityLinks = map[string]string{
+	"project":    "/api/v1/admin/projects/",
+	"experience": "/api/v1/admin/experiences/",
+	"tag":        "/api/v1/admin/tags/",
+}
+
+// Checker menjalankan rule yang aktif (Rules dikurangi yang di-disable lewat config)
+type Checker struct {
+	repo  repository.ContentHealthRepository
+	rules []Rule
+}
+
+func New(repo repository.ContentHealthRepository, disabled []string) *Checker {
+	off := map[string]bool{}
+	for _, id := range disabled {
+		off[strings.TrimSpace(id)] = true
+	}
+
+	var rules []Rule
+	for _, r := range Rules {
+		if !off[r.ID] {
+			rules = append(rules, r)
+		}
+	}
+
+	return &Checker{repo: repo, rules: rules}
+}
+
+// Enabled: rule yang aktif
+func (c *Checker) Enabled() []Rule {
+	return c.rules
+}
+
+func (c *Checker) queries() []repository.HealthQuery {
+	qs := make([]repository.HealthQuery, 0, len(c.rules))
+	for _, r := range c.rules {
+		qs = append(qs, repository.HealthQuery{Rule: r.ID, SQL: r.Query})
+	}
+	return qs
+}
+
+func (c *Checker) Run(ctx context.Context) ([]Finding, error) {
+	rows, err := c.repo.Find(ctx, c.queries())
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]Rule, len(c.rules))
+	for _, r := range c.rules {
+		byID[r.ID] = r
+	}
+
+	findings := make([]Finding, 0, len(rows))
+	for _, row := range rows {
+		r := byID[row.Rule]
+		findings = append(findings, Finding{
+			Rule:     r.ID,
+			Severity: r.Severity,
+			Message:  r.Message,
+			Fix:      r.Fix,
+			Entity: Entity{
+				Type:  r.Entity,
+				ID:    row.EntityID,
+				Label: row.Label,
+				Link:  entityLinks[r.Entity] + row.EntityID.String(),
+			},
+		})
+	}
+
+	return findings, nil
+}
+
+// Count: jumlah issue saja (untuk overview dashboard)
+func (c *Checker) Count(ctx context.Context) (int64, error) {
+	return c.repo.Count(ctx, c.queries())
+}
@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/FauzanParanditha/portfolio-backend/internal/repository"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+type AdminAnalyticsHandler struct {
+	repo repository.AnalyticsRepository
+}
+
+func NewAdminAnalyticsHandler(repo repository.AnalyticsRepository) *AdminAnalyticsHandler {
+	return &AdminAnalyticsHandler{repo: repo}
+}
+
+type ProjectTimeseriesResponse struct {
+	ProjectID string                     `json:"projectId"`
+	Days      int                        `json:"days"`
+	Series    []models.DailyViewStat     `json:"series"`
+	Referrers []models.ViewBreakdownStat `json:"referrers"`
+	Devices   []models.ViewBreakdownStat `json:"devices"`
+}
+
+// Helper: parse ?days= (default 30, max 365)
+func parseDaysQuery(c *fiber.Ctx, def int) int {
+	days, err := strconv.Atoi(c.Query("days", strconv.Itoa(def)))
+	if err != nil || days < 1 {
+		days = def
+	}
+	if days > 365 {
+		days = 365
+	}
+	return days
+}
+
+// awal hari (UTC) dari window N hari terakhir, termasuk hari ini
+func windowStartUTC(days int) time.Time {
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	return today.AddDate(0, 0, -(days - 1))
+}
+
+// GET /api/v1/admin/analytics/projects/top?days=30&limit=10
+// Admin Top Projects godoc
+// @Summary      Most viewed projects
+// @Tags         admin-analytics
+// @Security     BearerAuth
+// @Produce      json
+// @Param        days   query  int  false "Window in days (default 30)"
+// @Param        limit  query  int  false "Max projects (default 10)"
+// @Success      200  {array}   models.ProjectViewStat
+// @Failure      401  {object}  ErrorResponse
+// @Router       /admin/analytics/projects/top [get]
+func (h *AdminAnalyticsHandler) TopProjects(c *fiber.Ctx) error {
+	days := parseDaysQuery(c, 30)
+
+	limit, err := strconv.Atoi(c.Query("limit", "10"))
+	if err != nil || limit < 1 {
+		limit = 10
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stats, err := h.repo.TopProjects(ctx, windowStartUTC(days), limit)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to fetch top projects")
+		return fiber.NewError(http.StatusInternalServerError, "failed to fetch analytics")
+	}
+
+	if stats == nil {
+		stats = []models.ProjectViewStat{}
+	}
+
+	return c.JSON(fiber.Map{
+		"data": stats,
+		"meta": fiber.Map{
+			"days":  days,
+			"limit": limit,
+		},
+	})
+}
+
+// GET /api/v1/admin/analytics/projects/:id/timeseries?days=30
+// Admin Project Timeseries godoc
+// @Summary      Daily views of a project
+// @Description  Zero-filled daily views/uniques (UTC) plus referrer and device breakdown
+// @Tags         admin-analytics
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id    path   string  true  "Project ID"
+// @Param        days  query  int     false "Window in days (default 30)"
+// @Success      200  {object}  ProjectTimeseriesResponse
+// @Failure      400  {object}  ErrorResponse
+// @Router       /admin/analytics/projects/{id}/timeseries [get]
+func (h *AdminAnalyticsHandler) ProjectTimeseries(c *fiber.Ctx) error {
+	idStr := c.Params("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid project ID")
+	}
+
+	days := parseDaysQuery(c, 30)
+	since := windowStartUTC(days)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	daily, err := h.repo.ProjectDaily(ctx, id, since)
+	if err != nil {
+		log.Error().Err(err).Str("id", idStr).Msg("failed to fetch project timeseries")
+		return fiber.NewError(http.StatusInternalServerError, "failed to fetch analytics")
+	}
+
+	referrers, err := h.repo.ProjectBreakdown(ctx, id, since, "referrer_host")
+	if err != nil {
+		log.Error().Err(err).Str("id", idStr).Msg("failed to fetch project referrers")
+		return fiber.NewError(http.StatusInternalServerError, "failed to fetch analytics")
+	}
+
+	devices, err := h.repo.ProjectBreakdown(ctx, id, since, "ua_class")
+	if err != nil {
+		log.Error().Err(err).Str("id", idStr).Msg("failed to fetch project devices")
+		return fiber.NewError(http.StatusInternalServerError, "failed to fetch analytics")
+	}
+
+	// isi hari yang kosong dengan 0
+	byDay := make(map[string]models.DailyViewStat, len(daily))
+	for _, d := range daily {
+		byDay[d.Day] = d
+	}
+
+	series := make([]models.DailyViewStat, 0, days)
+	for i := 0; i < days; i++ {
+		day := since.AddDate(0, 0, i).Format("2006-01-02")
+		if d, ok := byDay[day]; ok {
+			series = append(series, d)
+			continue
+		}
+		series = append(series, models.DailyViewStat{Day: day})
+	}
+
+	if referrers == nil {
+		referrers = []models.ViewBreakdownStat{}
+	}
+	if devices == nil {
+		devices = []models.ViewBreakdownStat{}
+	}
+
+	return c.JSON(fiber.Map{
+		"data": ProjectTimeseriesResponse{
+			ProjectID: id.String(),
+			Days:      days,
+			Series:    series,
+			Referrers: referrers,
+			Devices:   devices,
+		},
+	})
+}
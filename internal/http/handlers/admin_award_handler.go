@@ -0,0 +1,340 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/changes"
+	"github.com/FauzanParanditha/portfolio-backend/internal/helpers"
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/FauzanParanditha/portfolio-backend/internal/validation"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+type AdminAwardHandler struct {
+	db *gorm.DB
+}
+
+func NewAdminAwardHandler(db *gorm.DB) *AdminAwardHandler {
+	return &AdminAwardHandler{db: db}
+}
+
+// Helper: isi field Award dari request
+func applyAwardRequest(a *models.Award, req AwardCreateRequest) error {
+	date, err := helpers.ParseDateStr(req.AwardDate)
+	if err != nil {
+		return err
+	}
+
+	a.Title = req.Title
+	a.Issuer = req.Issuer
+	a.AwardDate = date
+	a.URL = req.URL
+	a.Description = req.Description
+	a.SortOrder = req.SortOrder
+
+	return nil
+}
+
+// GET /api/v1/admin/awards
+// Admin List Awards godoc
+// @Summary      List all awards
+// @Tags         admin-awards
+// @Security     BearerAuth
+// @Produce      json
+// @Param        q      query  string  false  "Search title / issuer"
+// @Param        page   query  int     false  "Page"
+// @Param        limit  query  int     false  "Limit"
+// @Success      200  {object}  AwardListResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /admin/awards [get]
+func (h *AdminAwardHandler) List(c *fiber.Ctx) error {
+	q := c.Query("q")
+
+	page, err := strconv.Atoi(c.Query("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	limit, err := strconv.Atoi(c.Query("limit", "20"))
+	if err != nil || limit < 1 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	offset := (page - 1) * limit
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	qb := h.db.WithContext(ctx).Model(&models.Award{})
+
+	if q != "" {
+		like := "%" + q + "%"
+		qb = qb.Where(
+			h.db.Where("awards.title ILIKE ?", like).
+				Or("awards.issuer ILIKE ?", like),
+		)
+	}
+
+	var total int64
+	if err := qb.Count(&total).Error; err != nil {
+		log.Error().Err(err).Msg("failed to count awards (admin)")
+		return fiber.NewError(http.StatusInternalServerError, "failed to fetch awards")
+	}
+
+	var items []models.Award
+	if err := qb.
+		Preload("Tags").
+		Order("awards.sort_order ASC").
+		Order("awards.award_date DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&items).Error; err != nil {
+
+		log.Error().Err(err).Msg("failed to list awards (admin)")
+		return fiber.NewError(http.StatusInternalServerError, "failed to fetch awards")
+	}
+
+	resp := make([]AwardResponse, 0, len(items))
+	for _, e := range items {
+		resp = append(resp, awardToResponse(e))
+	}
+
+	return c.JSON(fiber.Map{
+		"data": resp,
+		"meta": PaginationMeta{
+			Page:    page,
+			Limit:   limit,
+			Total:   &total,
+			HasMore: int64(page*limit) < total,
+			Query:   q,
+		},
+	})
+}
+
+// GET /api/v1/admin/awards/:id
+// Admin Get Award godoc
+// @Summary      Get award
+// @Tags         admin-awards
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id   path  string  true  "Award ID"
+// @Success      200  {object}  AwardResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /admin/awards/{id} [get]
+func (h *AdminAwardHandler) GetByID(c *fiber.Ctx) error {
+	idStr := c.Params("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid award ID")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var item models.Award
+	if err := h.db.WithContext(ctx).Preload("Tags").First(&item, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return fiber.NewError(http.StatusNotFound, "award not found")
+		}
+
+		log.Error().Err(err).Str("id", idStr).Msg("failed to get award (admin)")
+		return fiber.NewError(http.StatusInternalServerError, "failed to fetch awards")
+	}
+
+	return c.JSON(fiber.Map{
+		"data": awardToResponse(item),
+	})
+}
+
+// POST /api/v1/admin/awards
+// Admin Create Award godoc
+// @Summary      Create award
+// @Tags         admin-awards
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        payload  body  AwardCreateRequest  true  "Award payload"
+// @Success      201  {object}  AwardResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      422  {object}  ErrorResponse
+// @Router       /admin/awards [post]
+func (h *AdminAwardHandler) Create(c *fiber.Ctx) error {
+	var req AwardCreateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid JSON body")
+	}
+
+	if err := validation.ValidateStruct(&req); err != nil {
+		return sendValidationError(c, validation.ToFieldErrors(err))
+	}
+
+	var item models.Award
+	if err := applyAwardRequest(&item, req); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid awardDate format, expected YYYY-MM-DD")
+	}
+
+	tagUUIDs, err := parseUUIDs(req.TagIDs)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid tagIds")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if len(tagUUIDs) > 0 {
+		var tags []models.Tag
+		if err := h.db.WithContext(ctx).Where("id IN ?", tagUUIDs).Find(&tags).Error; err != nil {
+			log.Error().Err(err).Msg("failed to load tags for award create")
+			return fiber.NewError(http.StatusInternalServerError, "failed to load tags")
+		}
+		item.Tags = tags
+	}
+
+	if err := h.db.WithContext(ctx).Create(&item).Error; err != nil {
+		log.Error().Err(err).Msg("failed to create award")
+		return fiber.NewError(http.StatusInternalServerError, "failed to create award")
+	}
+
+	changes.Notify(changes.Awards)
+
+	return c.Status(http.StatusCreated).JSON(fiber.Map{
+		"data": awardToResponse(item),
+	})
+}
+
+// PUT /api/v1/admin/awards/:id
+// Admin Update Award godoc
+// @Summary      Update award
+// @Tags         admin-awards
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id       path  string                  true  "Award ID"
+// @Param        payload  body  AwardUpdateRequest  true  "Award payload"
+// @Success      200  {object}  AwardResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      422  {object}  ErrorResponse
+// @Router       /admin/awards/{id} [put]
+func (h *AdminAwardHandler) Update(c *fiber.Ctx) error {
+	idStr := c.Params("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid award ID")
+	}
+
+	var req AwardUpdateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid JSON body")
+	}
+
+	if err := validation.ValidateStruct(&req); err != nil {
+		return sendValidationError(c, validation.ToFieldErrors(err))
+	}
+
+	tagUUIDs, err := parseUUIDs(req.TagIDs)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid tagIds")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	tx := h.db.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var item models.Award
+	if err := tx.First(&item, "id = ?", id).Error; err != nil {
+		tx.Rollback()
+		if err == gorm.ErrRecordNotFound {
+			return fiber.NewError(http.StatusNotFound, "award not found")
+		}
+		log.Error().Err(err).Str("id", idStr).Msg("failed to load award for update")
+		return fiber.NewError(http.StatusInternalServerError, "failed to update award")
+	}
+
+	if err := applyAwardRequest(&item, req); err != nil {
+		tx.Rollback()
+		return fiber.NewError(http.StatusBadRequest, "invalid awardDate format, expected YYYY-MM-DD")
+	}
+
+	if err := tx.Omit("Tags").Save(&item).Error; err != nil {
+		tx.Rollback()
+		log.Error().Err(err).Msg("failed to update award")
+		return fiber.NewError(http.StatusInternalServerError, "failed to update award")
+	}
+
+	var tags []models.Tag
+	if len(tagUUIDs) > 0 {
+		if err := tx.Where("id IN ?", tagUUIDs).Find(&tags).Error; err != nil {
+			tx.Rollback()
+			log.Error().Err(err).Msg("failed to load tags for award update")
+			return fiber.NewError(http.StatusInternalServerError, "failed to update tags")
+		}
+	}
+	if err := tx.Model(&item).Association("Tags").Replace(tags); err != nil {
+		tx.Rollback()
+		log.Error().Err(err).Msg("failed to update award tags")
+		return fiber.NewError(http.StatusInternalServerError, "failed to update tags")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		log.Error().Err(err).Msg("failed to commit award update")
+		return fiber.NewError(http.StatusInternalServerError, "failed to update award")
+	}
+
+	changes.Notify(changes.Awards)
+
+	item.Tags = tags
+
+	return c.JSON(fiber.Map{
+		"data": awardToResponse(item),
+	})
+}
+
+// DELETE /api/v1/admin/awards/:id
+// Admin Delete Award godoc
+// @Summary      Delete award
+// @Tags         admin-awards
+// @Security     BearerAuth
+// @Param        id   path  string  true  "Award ID"
+// @Success      204  "No Content"
+// @Failure      404  {object}  ErrorResponse
+// @Router       /admin/awards/{id} [delete]
+func (h *AdminAwardHandler) Delete(c *fiber.Ctx) error {
+	idStr := c.Params("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid award ID")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	res := h.db.WithContext(ctx).
+		Where("id = ?", id).
+		Delete(&models.Award{})
+	if res.Error != nil {
+		log.Error().Err(res.Error).Str("id", idStr).Msg("failed to delete award")
+		return fiber.NewError(http.StatusInternalServerError, "failed to delete award")
+	}
+	if res.RowsAffected == 0 {
+		return fiber.NewError(http.StatusNotFound, "award not found")
+	}
+
+	changes.Notify(changes.Awards)
+
+	return c.SendStatus(http.StatusNoContent)
+}
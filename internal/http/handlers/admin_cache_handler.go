@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"github.com/FauzanParanditha/portfolio-backend/internal/cache"
+	"github.com/gofiber/fiber/v2"
+)
+
+type AdminCacheHandler struct {
+	cache *cache.Cache // nil kalau cache dimatikan
+}
+
+func NewAdminCacheHandler(c *cache.Cache) *AdminCacheHandler {
+	return &AdminCacheHandler{cache: c}
+}
+
+// GET /api/v1/admin/cache/stats
+// Admin Cache Stats godoc
+// @Summary      Repository cache stats
+// @Description  Hit/miss/eviction counters of the in-memory cache in front of public project & experience queries
+// @Tags         admin-cache
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  CacheStatsResponse
+// @Failure      401  {object}  ErrorResponse
+// @Router       /admin/cache/stats [get]
+func (h *AdminCacheHandler) Stats(c *fiber.Ctx) error {
+	stats := h.cache.Stats()
+
+	var hitRatio float64
+	if total := stats.Hits + stats.Misses; total > 0 {
+		hitRatio = float64(stats.Hits) / float64(total)
+	}
+
+	return c.JSON(fiber.Map{
+		"data": CacheStatsResponse{
+			Enabled:  h.cache != nil,
+			Stats:    stats,
+			HitRatio: hitRatio,
+		},
+	})
+}
+
+type CacheStatsResponse struct {
+	Enabled bool `json:"enabled"`
+	cache.Stats
+	HitRatio float64 `json:"hitRatio"`
+}
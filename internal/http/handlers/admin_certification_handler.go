@@ -0,0 +1,362 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/changes"
+	"github.com/FauzanParanditha/portfolio-backend/internal/helpers"
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/FauzanParanditha/portfolio-backend/internal/validation"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+type AdminCertificationHandler struct {
+	db *gorm.DB
+}
+
+func NewAdminCertificationHandler(db *gorm.DB) *AdminCertificationHandler {
+	return &AdminCertificationHandler{db: db}
+}
+
+// Helper: isi field Certification dari request.
+// fieldErrors tidak kosong kalau expiryDate sebelum issueDate.
+func applyCertificationRequest(cert *models.Certification, req CertificationCreateRequest) (map[string]string, error) {
+	issued, err := helpers.ParseDateStr(req.IssueDate)
+	if err != nil {
+		return nil, err
+	}
+	expiry, err := parseOptionalDate(req.ExpiryDate)
+	if err != nil {
+		return nil, err
+	}
+	if expiry != nil && expiry.Before(issued) {
+		return map[string]string{"expiryDate": "tidak boleh sebelum issueDate"}, nil
+	}
+
+	cert.Name = req.Name
+	cert.Issuer = req.Issuer
+	cert.IssueDate = issued
+	cert.ExpiryDate = expiry
+	cert.CredentialID = req.CredentialID
+	cert.CredentialURL = req.CredentialURL
+	cert.Description = req.Description
+	cert.SortOrder = req.SortOrder
+
+	return nil, nil
+}
+
+// GET /api/v1/admin/certifications
+// Admin List Certifications godoc
+// @Summary      List all certifications
+// @Tags         admin-certifications
+// @Security     BearerAuth
+// @Produce      json
+// @Param        q      query  string  false  "Search name / issuer / credential ID"
+// @Param        page   query  int     false  "Page"
+// @Param        limit  query  int     false  "Limit"
+// @Success      200  {object}  CertificationListResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /admin/certifications [get]
+func (h *AdminCertificationHandler) List(c *fiber.Ctx) error {
+	q := c.Query("q")
+
+	page, err := strconv.Atoi(c.Query("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	limit, err := strconv.Atoi(c.Query("limit", "20"))
+	if err != nil || limit < 1 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	offset := (page - 1) * limit
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	qb := h.db.WithContext(ctx).Model(&models.Certification{})
+
+	if q != "" {
+		like := "%" + q + "%"
+		qb = qb.Where(
+			h.db.Where("certifications.name ILIKE ?", like).
+				Or("certifications.issuer ILIKE ?", like).
+				Or("certifications.credential_id ILIKE ?", like),
+		)
+	}
+
+	var total int64
+	if err := qb.Count(&total).Error; err != nil {
+		log.Error().Err(err).Msg("failed to count certifications (admin)")
+		return fiber.NewError(http.StatusInternalServerError, "failed to fetch certifications")
+	}
+
+	var items []models.Certification
+	if err := qb.
+		Preload("Tags").
+		Order("certifications.sort_order ASC").
+		Order("certifications.issue_date DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&items).Error; err != nil {
+
+		log.Error().Err(err).Msg("failed to list certifications (admin)")
+		return fiber.NewError(http.StatusInternalServerError, "failed to fetch certifications")
+	}
+
+	resp := make([]CertificationResponse, 0, len(items))
+	for _, e := range items {
+		resp = append(resp, certificationToResponse(e, time.Now()))
+	}
+
+	return c.JSON(fiber.Map{
+		"data": resp,
+		"meta": PaginationMeta{
+			Page:    page,
+			Limit:   limit,
+			Total:   &total,
+			HasMore: int64(page*limit) < total,
+			Query:   q,
+		},
+	})
+}
+
+// GET /api/v1/admin/certifications/:id
+// Admin Get Certification godoc
+// @Summary      Get certification
+// @Tags         admin-certifications
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id   path  string  true  "Certification ID"
+// @Success      200  {object}  CertificationResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /admin/certifications/{id} [get]
+func (h *AdminCertificationHandler) GetByID(c *fiber.Ctx) error {
+	idStr := c.Params("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid certification ID")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var item models.Certification
+	if err := h.db.WithContext(ctx).Preload("Tags").First(&item, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return fiber.NewError(http.StatusNotFound, "certification not found")
+		}
+
+		log.Error().Err(err).Str("id", idStr).Msg("failed to get certification (admin)")
+		return fiber.NewError(http.StatusInternalServerError, "failed to fetch certifications")
+	}
+
+	return c.JSON(fiber.Map{
+		"data": certificationToResponse(item, time.Now()),
+	})
+}
+
+// POST /api/v1/admin/certifications
+// Admin Create Certification godoc
+// @Summary      Create certification
+// @Description  expiryDate must not be before issueDate; empty = never expires
+// @Tags         admin-certifications
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        payload  body  CertificationCreateRequest  true  "Certification payload"
+// @Success      201  {object}  CertificationResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      422  {object}  ErrorResponse
+// @Router       /admin/certifications [post]
+func (h *AdminCertificationHandler) Create(c *fiber.Ctx) error {
+	var req CertificationCreateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid JSON body")
+	}
+
+	if err := validation.ValidateStruct(&req); err != nil {
+		return sendValidationError(c, validation.ToFieldErrors(err))
+	}
+
+	var item models.Certification
+	fieldErrors, err := applyCertificationRequest(&item, req)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid date format, expected YYYY-MM-DD")
+	}
+	if len(fieldErrors) > 0 {
+		return sendValidationError(c, fieldErrors)
+	}
+
+	tagUUIDs, err := parseUUIDs(req.TagIDs)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid tagIds")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if len(tagUUIDs) > 0 {
+		var tags []models.Tag
+		if err := h.db.WithContext(ctx).Where("id IN ?", tagUUIDs).Find(&tags).Error; err != nil {
+			log.Error().Err(err).Msg("failed to load tags for certification create")
+			return fiber.NewError(http.StatusInternalServerError, "failed to load tags")
+		}
+		item.Tags = tags
+	}
+
+	if err := h.db.WithContext(ctx).Create(&item).Error; err != nil {
+		log.Error().Err(err).Msg("failed to create certification")
+		return fiber.NewError(http.StatusInternalServerError, "failed to create certification")
+	}
+
+	changes.Notify(changes.Certifications)
+
+	return c.Status(http.StatusCreated).JSON(fiber.Map{
+		"data": certificationToResponse(item, time.Now()),
+	})
+}
+
+// PUT /api/v1/admin/certifications/:id
+// Admin Update Certification godoc
+// @Summary      Update certification
+// @Description  expiryDate must not be before issueDate; empty = never expires
+// @Tags         admin-certifications
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id       path  string                  true  "Certification ID"
+// @Param        payload  body  CertificationUpdateRequest  true  "Certification payload"
+// @Success      200  {object}  CertificationResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      422  {object}  ErrorResponse
+// @Router       /admin/certifications/{id} [put]
+func (h *AdminCertificationHandler) Update(c *fiber.Ctx) error {
+	idStr := c.Params("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid certification ID")
+	}
+
+	var req CertificationUpdateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid JSON body")
+	}
+
+	if err := validation.ValidateStruct(&req); err != nil {
+		return sendValidationError(c, validation.ToFieldErrors(err))
+	}
+
+	tagUUIDs, err := parseUUIDs(req.TagIDs)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid tagIds")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	tx := h.db.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var item models.Certification
+	if err := tx.First(&item, "id = ?", id).Error; err != nil {
+		tx.Rollback()
+		if err == gorm.ErrRecordNotFound {
+			return fiber.NewError(http.StatusNotFound, "certification not found")
+		}
+		log.Error().Err(err).Str("id", idStr).Msg("failed to load certification for update")
+		return fiber.NewError(http.StatusInternalServerError, "failed to update certification")
+	}
+
+	fieldErrors, err := applyCertificationRequest(&item, req)
+	if err != nil {
+		tx.Rollback()
+		return fiber.NewError(http.StatusBadRequest, "invalid date format, expected YYYY-MM-DD")
+	}
+	if len(fieldErrors) > 0 {
+		tx.Rollback()
+		return sendValidationError(c, fieldErrors)
+	}
+
+	if err := tx.Omit("Tags").Save(&item).Error; err != nil {
+		tx.Rollback()
+		log.Error().Err(err).Msg("failed to update certification")
+		return fiber.NewError(http.StatusInternalServerError, "failed to update certification")
+	}
+
+	var tags []models.Tag
+	if len(tagUUIDs) > 0 {
+		if err := tx.Where("id IN ?", tagUUIDs).Find(&tags).Error; err != nil {
+			tx.Rollback()
+			log.Error().Err(err).Msg("failed to load tags for certification update")
+			return fiber.NewError(http.StatusInternalServerError, "failed to update tags")
+		}
+	}
+	if err := tx.Model(&item).Association("Tags").Replace(tags); err != nil {
+		tx.Rollback()
+		log.Error().Err(err).Msg("failed to update certification tags")
+		return fiber.NewError(http.StatusInternalServerError, "failed to update tags")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		log.Error().Err(err).Msg("failed to commit certification update")
+		return fiber.NewError(http.StatusInternalServerError, "failed to update certification")
+	}
+
+	changes.Notify(changes.Certifications)
+
+	item.Tags = tags
+
+	return c.JSON(fiber.Map{
+		"data": certificationToResponse(item, time.Now()),
+	})
+}
+
+// DELETE /api/v1/admin/certifications/:id
+// Admin Delete Certification godoc
+// @Summary      Delete certification
+// @Tags         admin-certifications
+// @Security     BearerAuth
+// @Param        id   path  string  true  "Certification ID"
+// @Success      204  "No Content"
+// @Failure      404  {object}  ErrorResponse
+// @Router       /admin/certifications/{id} [delete]
+func (h *AdminCertificationHandler) Delete(c *fiber.Ctx) error {
+	idStr := c.Params("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid certification ID")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	res := h.db.WithContext(ctx).
+		Where("id = ?", id).
+		Delete(&models.Certification{})
+	if res.Error != nil {
+		log.Error().Err(res.Error).Str("id", idStr).Msg("failed to delete certification")
+		return fiber.NewError(http.StatusInternalServerError, "failed to delete certification")
+	}
+	if res.RowsAffected == 0 {
+		return fiber.NewError(http.StatusNotFound, "certification not found")
+	}
+
+	changes.Notify(changes.Certifications)
+
+	return c.SendStatus(http.StatusNoContent)
+}
@@ -31,10 +31,12 @@ func NewAdminContactHandler(db *gorm.DB, repo repository.ContactMessageRepositor
 // @Summary      View inbox messages
 // @Tags         admin-contact
 // @Security     BearerAuth
-// @Param        q      query string false "Search"
-// @Param        isRead query bool   false "Filter read/unread"
-// @Param        page   query int    false "Page"
-// @Param        limit  query int    false "Limit"
+// @Param        q          query string false "Search"
+// @Param        isRead     query bool   false "Filter read/unread"
+// @Param        page       query int    false "Page"
+// @Param        limit      query int    false "Limit"
+// @Param        cursor     query string false "Opaque cursor from meta.nextCursor / meta.prevCursor"
+// @Param        withTotal  query bool   false "Include total count (default false)"
 // @Success      200  {array} ContactMessageResponse
 // @Router       /admin/contact-messages [get]
 func (h *AdminContactHandler) List(c *fiber.Ctx) error {
@@ -62,17 +64,25 @@ func (h *AdminContactHandler) List(c *fiber.Ctx) error {
 		isRead = &v
 	}
 
+	list := cursorList(c, "admin-contact-messages")
+	cursor, err := parseCursorQuery(c, list)
+	if err != nil {
+		return err
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
 	params := repository.ContactListParams{
-		Query:  q,
-		IsRead: isRead,
-		Page:   page,
-		Limit:  limit,
+		Query:     q,
+		IsRead:    isRead,
+		Page:      page,
+		Limit:     limit,
+		Cursor:    cursor,
+		WithTotal: withTotalQuery(c),
 	}
 
-	msgs, total, err := h.repo.List(ctx, params)
+	msgs, info, err := h.repo.List(ctx, params)
 	if err != nil {
 		log.Error().Err(err).Msg("failed to list contact messages (admin)")
 		return fiber.NewError(http.StatusInternalServerError, "failed to fetch contact messages")
@@ -83,18 +93,24 @@ func (h *AdminContactHandler) List(c *fiber.Ctx) error {
 		resp = append(resp, contactToResponse(m))
 	}
 
-	hasMore := int64(page*limit) < total
+	next, prev := cursorLinks(list, msgs, info, contactCursor)
+
+	meta := fiber.Map{
+		"page":       page,
+		"limit":      limit,
+		"hasMore":    info.HasNext,
+		"nextCursor": next,
+		"prevCursor": prev,
+		"q":          q,
+		"isRead":     isReadStr,
+	}
+	if info.Total != nil {
+		meta["total"] = *info.Total
+	}
 
 	return c.JSON(fiber.Map{
 		"data": resp,
-		"meta": fiber.Map{
-			"page":    page,
-			"limit":   limit,
-			"total":   total,
-			"hasMore": hasMore,
-			"q":       q,
-			"isRead":  isReadStr,
-		},
+		"meta": meta,
 	})
 }
 
@@ -1,62 +1,190 @@
 package handlers
 
 import (
+	"context"
 	"net/http"
 	"time"
 
+	"github.com/FauzanParanditha/portfolio-backend/internal/contenthealth"
 	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/FauzanParanditha/portfolio-backend/internal/repository"
 	"github.com/gofiber/fiber/v2"
-	"gorm.io/gorm"
+	"github.com/rs/zerolog/log"
 )
 
 type AdminDashboardHandler struct {
-	db *gorm.DB
+	repo   repository.DashboardRepository
+	health *contenthealth.Checker
 }
 
-func NewAdminDashboardHandler(db *gorm.DB) *AdminDashboardHandler {
-	return &AdminDashboardHandler{db: db}
+func NewAdminDashboardHandler(repo repository.DashboardRepository, health *contenthealth.Checker) *AdminDashboardHandler {
+	return &AdminDashboardHandler{repo: repo, health: health}
 }
 
+// GET /api/v1/admin/dashboard/overview?days=30
+// Admin Dashboard Overview godoc
+// @Summary      Dashboard overview
+// @Description  Totals plus counts created in the last N days
+// @Tags         admin-dashboard
+// @Security     BearerAuth
+// @Produce      json
+// @Param        days  query  int  false "Recent window in days (default 30)"
+// @Success      200  {object}  models.DashboardOverviewResponse
+// @Failure      401  {object}  ErrorResponse
+// @Router       /admin/dashboard/overview [get]
 func (h *AdminDashboardHandler) Overview(c *fiber.Ctx) error {
-	recentDays := 30
+	recentDays := parseDaysQuery(c, 30)
 	since := time.Now().AddDate(0, 0, -recentDays)
 
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	counts, err := h.repo.Counts(ctx, since)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to count dashboard overview")
+		return fiber.NewError(http.StatusInternalServerError, "failed to fetch dashboard overview")
+	}
+
+	issues, err := h.health.Count(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to count content health issues")
+		return fiber.NewError(http.StatusInternalServerError, "failed to fetch dashboard overview")
+	}
+
 	var resp models.DashboardOverviewResponse
 	resp.System.ServerTime = time.Now()
 	resp.System.RecentDays = recentDays
 
-	// ===== Projects =====
-	if err := h.db.Model(&models.Project{}).Count(&resp.Projects.Total).Error; err != nil {
-		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"message": "failed to count projects"})
-	}
-	if err := h.db.Model(&models.Project{}).Where("is_featured = ?", true).Count(&resp.Projects.Featured).Error; err != nil {
-		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"message": "failed to count featured projects"})
-	}
-	if err := h.db.Model(&models.Project{}).Where("created_at >= ?", since).Count(&resp.Projects.RecentCount).Error; err != nil {
-		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"message": "failed to count recent projects"})
+	resp.Projects.Total = counts.ProjectsTotal
+	resp.Projects.Featured = counts.ProjectsFeatured
+	resp.Projects.RecentCount = counts.ProjectsRecent
+
+	resp.Experiences.Total = counts.ExperiencesTotal
+	resp.Experiences.Current = counts.ExperiencesCurrent
+	resp.Experiences.RecentCount = counts.ExperiencesRecent
+
+	resp.ContactMessages.Total = counts.ContactTotal
+	resp.ContactMessages.Unread = counts.ContactUnread
+	resp.ContactMessages.RecentCount = counts.ContactRecent
+
+	resp.Links.Total = counts.LinksTotal
+	resp.Links.Broken = counts.LinksBroken
+
+	resp.Health.Issues = issues
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{"data": resp})
+}
+
+// GET /api/v1/admin/dashboard/timeseries?days=30&tz=Asia/Jakarta
+// Admin Dashboard Timeseries godoc
+// @Summary      Daily created items
+// @Description  Zero-filled daily buckets of created projects, experiences and contact messages
+// @Tags         admin-dashboard
+// @Security     BearerAuth
+// @Produce      json
+// @Param        days  query  int     false "Window in days (default 30)"
+// @Param        tz    query  string  false "IANA timezone for day boundaries (default UTC)"
+// @Success      200  {object}  DashboardTimeseriesResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Router       /admin/dashboard/timeseries [get]
+func (h *AdminDashboardHandler) Timeseries(c *fiber.Ctx) error {
+	days := parseDaysQuery(c, 30)
+
+	tz := c.Query("tz", "UTC")
+	loc, err := time.LoadLocation(tz)
+	if err != nil || tz == "Local" {
+		return fiber.NewError(http.StatusBadRequest, "invalid timezone")
 	}
 
-	// ===== Experiences =====
-	if err := h.db.Model(&models.Experience{}).Count(&resp.Experiences.Total).Error; err != nil {
-		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"message": "failed to count experiences"})
+	// awal hari (di tz) dari window N hari terakhir, termasuk hari ini
+	now := time.Now().In(loc)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	from := today.AddDate(0, 0, -(days - 1))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	series, err := h.repo.DailyCreated(ctx, from, days)
+	if err != nil {
+		log.Error().Err(err).Str("tz", tz).Msg("failed to fetch dashboard timeseries")
+		return fiber.NewError(http.StatusInternalServerError, "failed to fetch dashboard timeseries")
 	}
-	if err := h.db.Model(&models.Experience{}).Where("is_current = ?", true).Count(&resp.Experiences.Current).Error; err != nil {
-		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"message": "failed to count current experiences"})
+
+	if series == nil {
+		series = []models.DashboardDailyStat{}
 	}
-	if err := h.db.Model(&models.Experience{}).Where("created_at >= ?", since).Count(&resp.Experiences.RecentCount).Error; err != nil {
-		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"message": "failed to count recent experiences"})
+
+	return c.JSON(fiber.Map{
+		"data": DashboardTimeseriesResponse{
+			Days:     days,
+			Timezone: loc.String(),
+			Series:   series,
+		},
+	})
+}
+
+// GET /api/v1/admin/dashboard/health?severity=error&entity=project
+// Admin Content Health godoc
+// @Summary      Content health report
+// @Description  Runs the enabled content lint rules over projects, experiences and tags
+// @Tags         admin-dashboard
+// @Security     BearerAuth
+// @Produce      json
+// @Param        severity  query  string  false "error | warning | info"
+// @Param        entity    query  string  false "project | experience | tag"
+// @Success      200  {array}   contenthealth.Finding
+// @Failure      401  {object}  ErrorResponse
+// @Router       /admin/dashboard/health [get]
+func (h *AdminDashboardHandler) Health(c *fiber.Ctx) error {
+	severity := c.Query("severity")
+	entity := c.Query("entity")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	findings, err := h.health.Run(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to run content health rules")
+		return fiber.NewError(http.StatusInternalServerError, "failed to fetch content health")
 	}
 
-	// ===== Contact Messages =====
-	if err := h.db.Model(&models.ContactMessage{}).Count(&resp.ContactMessages.Total).Error; err != nil {
-		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"message": "failed to count contact messages"})
+	bySeverity := map[contenthealth.Severity]int{
+		contenthealth.SeverityError:   0,
+		contenthealth.SeverityWarning: 0,
+		contenthealth.SeverityInfo:    0,
 	}
-	if err := h.db.Model(&models.ContactMessage{}).Where("is_read = ?", false).Count(&resp.ContactMessages.Unread).Error; err != nil {
-		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"message": "failed to count unread messages"})
+
+	filtered := make([]contenthealth.Finding, 0, len(findings))
+	for _, f := range findings {
+		bySeverity[f.Severity]++
+
+		if severity != "" && string(f.Severity) != severity {
+			continue
+		}
+		if entity != "" && f.Entity.Type != entity {
+			continue
+		}
+		filtered = append(filtered, f)
 	}
-	if err := h.db.Model(&models.ContactMessage{}).Where("created_at >= ?", since).Count(&resp.ContactMessages.RecentCount).Error; err != nil {
-		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"message": "failed to count recent messages"})
+
+	rules := make([]string, 0, len(h.health.Enabled()))
+	for _, r := range h.health.Enabled() {
+		rules = append(rules, r.ID)
 	}
 
-	return c.Status(http.StatusOK).JSON(fiber.Map{"data": resp})
+	return c.JSON(fiber.Map{
+		"data": filtered,
+		"meta": fiber.Map{
+			"total":      len(findings),
+			"bySeverity": bySeverity,
+			"rules":      rules,
+		},
+	})
+}
+
+type DashboardTimeseriesResponse struct {
+	Days     int                         `json:"days"`
+	Timezone string                      `json:"timezone"`
+	Series   []models.DashboardDailyStat `json:"series"`
 }
@@ -0,0 +1,379 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/changes"
+	"github.com/FauzanParanditha/portfolio-backend/internal/helpers"
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/FauzanParanditha/portfolio-backend/internal/validation"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+type AdminEducationHandler struct {
+	db *gorm.DB
+}
+
+func NewAdminEducationHandler(db *gorm.DB) *AdminEducationHandler {
+	return &AdminEducationHandler{db: db}
+}
+
+// Helper: parse tanggal opsional "2006-01-02" (nil / "" → nil)
+func parseOptionalDate(s *string) (*time.Time, error) {
+	if s == nil || *s == "" {
+		return nil, nil
+	}
+	t, err := helpers.ParseDateStr(*s)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// Helper: isi field Education dari request. isCurrent = true → endDate diabaikan.
+// fieldErrors tidak kosong kalau tanggal tidak konsisten.
+func applyEducationRequest(e *models.Education, req EducationCreateRequest) (map[string]string, error) {
+	start, err := helpers.ParseDateStr(req.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	end, err := parseOptionalDate(req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	if req.IsCurrent {
+		end = nil
+	}
+	if end != nil && end.Before(start) {
+		return map[string]string{"endDate": "tidak boleh sebelum startDate"}, nil
+	}
+
+	e.Institution = req.Institution
+	e.Degree = req.Degree
+	e.FieldOfStudy = req.FieldOfStudy
+	e.Location = req.Location
+	e.StartDate = start
+	e.EndDate = end
+	e.IsCurrent = req.IsCurrent
+	e.Grade = req.Grade
+	e.Description = req.Description
+	e.SortOrder = req.SortOrder
+
+	return nil, nil
+}
+
+// GET /api/v1/admin/education
+// Admin List Education godoc
+// @Summary      List all education entries
+// @Tags         admin-education
+// @Security     BearerAuth
+// @Produce      json
+// @Param        q      query  string  false  "Search institution / degree / field of study"
+// @Param        page   query  int     false  "Page"
+// @Param        limit  query  int     false  "Limit"
+// @Success      200  {object}  EducationListResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /admin/education [get]
+func (h *AdminEducationHandler) List(c *fiber.Ctx) error {
+	q := c.Query("q")
+
+	page, err := strconv.Atoi(c.Query("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	limit, err := strconv.Atoi(c.Query("limit", "20"))
+	if err != nil || limit < 1 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	offset := (page - 1) * limit
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	qb := h.db.WithContext(ctx).Model(&models.Education{})
+
+	if q != "" {
+		like := "%" + q + "%"
+		qb = qb.Where(
+			h.db.Where("educations.institution ILIKE ?", like).
+				Or("educations.degree ILIKE ?", like).
+				Or("educations.field_of_study ILIKE ?", like),
+		)
+	}
+
+	var total int64
+	if err := qb.Count(&total).Error; err != nil {
+		log.Error().Err(err).Msg("failed to count education (admin)")
+		return fiber.NewError(http.StatusInternalServerError, "failed to fetch education")
+	}
+
+	var items []models.Education
+	if err := qb.
+		Preload("Tags").
+		Order("educations.sort_order ASC").
+		Order("educations.start_date DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&items).Error; err != nil {
+
+		log.Error().Err(err).Msg("failed to list education (admin)")
+		return fiber.NewError(http.StatusInternalServerError, "failed to fetch education")
+	}
+
+	resp := make([]EducationResponse, 0, len(items))
+	for _, e := range items {
+		resp = append(resp, educationToResponse(e))
+	}
+
+	return c.JSON(fiber.Map{
+		"data": resp,
+		"meta": PaginationMeta{
+			Page:    page,
+			Limit:   limit,
+			Total:   &total,
+			HasMore: int64(page*limit) < total,
+			Query:   q,
+		},
+	})
+}
+
+// GET /api/v1/admin/education/:id
+// Admin Get Education godoc
+// @Summary      Get education entry
+// @Tags         admin-education
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id   path  string  true  "Education ID"
+// @Success      200  {object}  EducationResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /admin/education/{id} [get]
+func (h *AdminEducationHandler) GetByID(c *fiber.Ctx) error {
+	idStr := c.Params("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid education ID")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var item models.Education
+	if err := h.db.WithContext(ctx).Preload("Tags").First(&item, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return fiber.NewError(http.StatusNotFound, "education not found")
+		}
+
+		log.Error().Err(err).Str("id", idStr).Msg("failed to get education (admin)")
+		return fiber.NewError(http.StatusInternalServerError, "failed to fetch education")
+	}
+
+	return c.JSON(fiber.Map{
+		"data": educationToResponse(item),
+	})
+}
+
+// POST /api/v1/admin/education
+// Admin Create Education godoc
+// @Summary      Create education entry
+// @Description  endDate is ignored when isCurrent is true
+// @Tags         admin-education
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        payload  body  EducationCreateRequest  true  "Education payload"
+// @Success      201  {object}  EducationResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      422  {object}  ErrorResponse
+// @Router       /admin/education [post]
+func (h *AdminEducationHandler) Create(c *fiber.Ctx) error {
+	var req EducationCreateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid JSON body")
+	}
+
+	if err := validation.ValidateStruct(&req); err != nil {
+		return sendValidationError(c, validation.ToFieldErrors(err))
+	}
+
+	var item models.Education
+	fieldErrors, err := applyEducationRequest(&item, req)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid date format, expected YYYY-MM-DD")
+	}
+	if len(fieldErrors) > 0 {
+		return sendValidationError(c, fieldErrors)
+	}
+
+	tagUUIDs, err := parseUUIDs(req.TagIDs)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid tagIds")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if len(tagUUIDs) > 0 {
+		var tags []models.Tag
+		if err := h.db.WithContext(ctx).Where("id IN ?", tagUUIDs).Find(&tags).Error; err != nil {
+			log.Error().Err(err).Msg("failed to load tags for education create")
+			return fiber.NewError(http.StatusInternalServerError, "failed to load tags")
+		}
+		item.Tags = tags
+	}
+
+	if err := h.db.WithContext(ctx).Create(&item).Error; err != nil {
+		log.Error().Err(err).Msg("failed to create education")
+		return fiber.NewError(http.StatusInternalServerError, "failed to create education")
+	}
+
+	changes.Notify(changes.Education)
+
+	return c.Status(http.StatusCreated).JSON(fiber.Map{
+		"data": educationToResponse(item),
+	})
+}
+
+// PUT /api/v1/admin/education/:id
+// Admin Update Education godoc
+// @Summary      Update education entry
+// @Description  endDate is ignored when isCurrent is true
+// @Tags         admin-education
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id       path  string                  true  "Education ID"
+// @Param        payload  body  EducationUpdateRequest  true  "Education payload"
+// @Success      200  {object}  EducationResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      422  {object}  ErrorResponse
+// @Router       /admin/education/{id} [put]
+func (h *AdminEducationHandler) Update(c *fiber.Ctx) error {
+	idStr := c.Params("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid education ID")
+	}
+
+	var req EducationUpdateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid JSON body")
+	}
+
+	if err := validation.ValidateStruct(&req); err != nil {
+		return sendValidationError(c, validation.ToFieldErrors(err))
+	}
+
+	tagUUIDs, err := parseUUIDs(req.TagIDs)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid tagIds")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	tx := h.db.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var item models.Education
+	if err := tx.First(&item, "id = ?", id).Error; err != nil {
+		tx.Rollback()
+		if err == gorm.ErrRecordNotFound {
+			return fiber.NewError(http.StatusNotFound, "education not found")
+		}
+		log.Error().Err(err).Str("id", idStr).Msg("failed to load education for update")
+		return fiber.NewError(http.StatusInternalServerError, "failed to update education")
+	}
+
+	fieldErrors, err := applyEducationRequest(&item, req)
+	if err != nil {
+		tx.Rollback()
+		return fiber.NewError(http.StatusBadRequest, "invalid date format, expected YYYY-MM-DD")
+	}
+	if len(fieldErrors) > 0 {
+		tx.Rollback()
+		return sendValidationError(c, fieldErrors)
+	}
+
+	if err := tx.Omit("Tags").Save(&item).Error; err != nil {
+		tx.Rollback()
+		log.Error().Err(err).Msg("failed to update education")
+		return fiber.NewError(http.StatusInternalServerError, "failed to update education")
+	}
+
+	var tags []models.Tag
+	if len(tagUUIDs) > 0 {
+		if err := tx.Where("id IN ?", tagUUIDs).Find(&tags).Error; err != nil {
+			tx.Rollback()
+			log.Error().Err(err).Msg("failed to load tags for education update")
+			return fiber.NewError(http.StatusInternalServerError, "failed to update tags")
+		}
+	}
+	if err := tx.Model(&item).Association("Tags").Replace(tags); err != nil {
+		tx.Rollback()
+		log.Error().Err(err).Msg("failed to update education tags")
+		return fiber.NewError(http.StatusInternalServerError, "failed to update tags")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		log.Error().Err(err).Msg("failed to commit education update")
+		return fiber.NewError(http.StatusInternalServerError, "failed to update education")
+	}
+
+	changes.Notify(changes.Education)
+
+	item.Tags = tags
+
+	return c.JSON(fiber.Map{
+		"data": educationToResponse(item),
+	})
+}
+
+// DELETE /api/v1/admin/education/:id
+// Admin Delete Education godoc
+// @Summary      Delete education entry
+// @Tags         admin-education
+// @Security     BearerAuth
+// @Param        id   path  string  true  "Education ID"
+// @Success      204  "No Content"
+// @Failure      404  {object}  ErrorResponse
+// @Router       /admin/education/{id} [delete]
+func (h *AdminEducationHandler) Delete(c *fiber.Ctx) error {
+	idStr := c.Params("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid education ID")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	res := h.db.WithContext(ctx).
+		Where("id = ?", id).
+		Delete(&models.Education{})
+	if res.Error != nil {
+		log.Error().Err(res.Error).Str("id", idStr).Msg("failed to delete education")
+		return fiber.NewError(http.StatusInternalServerError, "failed to delete education")
+	}
+	if res.RowsAffected == 0 {
+		return fiber.NewError(http.StatusNotFound, "education not found")
+	}
+
+	changes.Notify(changes.Education)
+
+	return c.SendStatus(http.StatusNoContent)
+}
@@ -2,12 +2,15 @@ package handlers
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"strconv"
 	"time"
 
+	"github.com/FauzanParanditha/portfolio-backend/internal/changes"
 	"github.com/FauzanParanditha/portfolio-backend/internal/helpers"
 	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/FauzanParanditha/portfolio-backend/internal/repository"
 	"github.com/FauzanParanditha/portfolio-backend/internal/validation"
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
@@ -23,6 +26,49 @@ func NewAdminExperienceHandler(db *gorm.DB) *AdminExperienceHandler {
 	return &AdminExperienceHandler{db: db}
 }
 
+// Helper: role full-time lain yang masa kerjanya beririsan dengan exp.
+// Hanya peringatan; kalau query gagal cukup di-log dan dianggap tidak ada overlap.
+func experienceOverlapWarnings(db *gorm.DB, exp models.Experience) []ExperienceWarning {
+	warnings := []ExperienceWarning{}
+	if exp.EmploymentType != models.EmploymentFullTime {
+		return warnings
+	}
+
+	q := db.Model(&models.Experience{}).
+		Where("id <> ?", exp.ID).
+		Where("employment_type = ?", models.EmploymentFullTime).
+		Where("(is_current OR end_date IS NULL OR end_date > ?)", exp.StartDate)
+	if !exp.IsCurrent && exp.EndDate != nil {
+		q = q.Where("start_date < ?", *exp.EndDate)
+	}
+
+	var others []models.Experience
+	if err := q.Order("start_date ASC").Find(&others).Error; err != nil {
+		log.Warn().Err(err).Str("id", exp.ID.String()).Msg("failed to check experience overlaps")
+		return warnings
+	}
+
+	for _, o := range others {
+		r := experienceToResponse(o)
+		until := "sekarang"
+		if r.EndDate != nil {
+			until = *r.EndDate
+		}
+
+		warnings = append(warnings, ExperienceWarning{
+			Code:         "overlap",
+			Message:      fmt.Sprintf("beririsan dengan role full-time %q di %s (%s s/d %s)", o.Title, o.Company, r.StartDate, until),
+			ExperienceID: r.ID,
+			Title:        o.Title,
+			Company:      o.Company,
+			StartDate:    r.StartDate,
+			EndDate:      r.EndDate,
+		})
+	}
+
+	return warnings
+}
+
 // GET /api/v1/admin/experiences
 // Admin List Experiences godoc
 // @Summary      List all experiences
@@ -31,7 +77,10 @@ func NewAdminExperienceHandler(db *gorm.DB) *AdminExperienceHandler {
 // @Param        q     query string false "Search"
 // @Param        page  query int    false "Page"
 // @Param        limit query int    false "Limit"
+// @Param        fields   query string false "Comma-separated response fields"
+// @Param        include  query string false "Relations to load: highlights,tags,projects,organization"
 // @Success      200  {array} ExperienceResponse
+// @Failure      400  {object} ErrorResponse
 // @Router       /admin/experiences [get]
 func (h *AdminExperienceHandler) List(c *fiber.Ctx) error {
 	q := c.Query("q")
@@ -49,15 +98,15 @@ func (h *AdminExperienceHandler) List(c *fiber.Ctx) error {
 	}
 	offset := (page - 1) * limit
 
+	sparse, err := parseSparseQuery(c, experienceFieldSpec)
+	if err != nil {
+		return err
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	qb := h.db.WithContext(ctx).
-		Preload("Highlights", func(db *gorm.DB) *gorm.DB {
-			return db.Order("experience_highlights.sort_order ASC")
-		}).
-		Preload("Tags").
-		Model(&models.Experience{})
+	qb := h.db.WithContext(ctx).Model(&models.Experience{})
 
 	if q != "" {
 		like := "%" + q + "%"
@@ -74,7 +123,7 @@ func (h *AdminExperienceHandler) List(c *fiber.Ctx) error {
 	}
 
 	var exps []models.Experience
-	if err := qb.
+	if err := repository.ExperienceQuery(qb, sparse.proj).
 		Order("experiences.sort_order ASC").
 		Order("experiences.start_date DESC").
 		Limit(limit).
@@ -93,11 +142,11 @@ func (h *AdminExperienceHandler) List(c *fiber.Ctx) error {
 	hasMore := int64(page*limit) < total
 
 	return c.JSON(fiber.Map{
-		"data": resp,
+		"data": sparseList(sparse, resp, experienceFieldSpec),
 		"meta": PaginationMeta{
 			Page:     page,
 			Limit:    limit,
-			Total:    total,
+			Total:    &total,
 			HasMore:  hasMore,
 			Query:    q,
 			Featured: false, // nggak relevan di experiences, tapi field-nya ada
@@ -110,8 +159,11 @@ func (h *AdminExperienceHandler) List(c *fiber.Ctx) error {
 // @Summary      Get experience detail
 // @Tags         admin-experiences
 // @Security     BearerAuth
-// @Param        id  path string true "ID"
+// @Param        id       path  string true  "ID"
+// @Param        fields   query string false "Comma-separated response fields"
+// @Param        include  query string false "Relations to load: highlights,tags,projects,organization"
 // @Success      200 {object} ExperienceResponse
+// @Failure      400 {object} ErrorResponse
 // @Router       /admin/experiences/{id} [get]
 func (h *AdminExperienceHandler) GetByID(c *fiber.Ctx) error {
 	idStr := c.Params("id")
@@ -121,16 +173,17 @@ func (h *AdminExperienceHandler) GetByID(c *fiber.Ctx) error {
 		return fiber.NewError(http.StatusBadRequest, "invalid experience ID")
 	}
 
+	sparse, err := parseSparseQuery(c, experienceFieldSpec)
+	if err != nil {
+		return err
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
 	var exp models.Experience
-	if err := h.db.WithContext(ctx).
-		Preload("Highlights", func(db *gorm.DB) *gorm.DB {
-			return db.Order("experience_highlights.sort_order ASC")
-		}).
-		Preload("Tags").
-		First(&exp, "id = ?", id).Error; err != nil {
+	if err := repository.ExperienceQuery(h.db.WithContext(ctx), sparse.proj).
+		First(&exp, "experiences.id = ?", id).Error; err != nil {
 
 		if err == gorm.ErrRecordNotFound {
 			return fiber.NewError(http.StatusNotFound, "experience not found")
@@ -141,17 +194,19 @@ func (h *AdminExperienceHandler) GetByID(c *fiber.Ctx) error {
 	}
 
 	return c.JSON(fiber.Map{
-		"data": experienceToResponse(exp),
+		"data": sparse.apply(experienceToResponse(exp), experienceFieldSpec),
 	})
 }
 
 // POST /api/v1/admin/experiences
 // Admin Create Experience godoc
 // @Summary      Create new experience
+// @Description  isCurrent = true requires an empty endDate; otherwise endDate is required and must not be before startDate. warnings lists other full-time roles whose dates overlap (the experience is still saved). With organizationId, company is taken from the organization name (and location from the organization when empty).
 // @Tags         admin-experiences
 // @Security     BearerAuth
 // @Param        payload  body  ExperienceCreateRequest true "Experience payload"
-// @Success      201      {object}  ExperienceResponse
+// @Success      201      {object}  ExperienceWriteResponse
+// @Failure      422      {object}  ErrorResponse
 // @Router       /admin/experiences [post]
 func (h *AdminExperienceHandler) Create(c *fiber.Ctx) error {
 	var req ExperienceCreateRequest
@@ -160,6 +215,13 @@ func (h *AdminExperienceHandler) Create(c *fiber.Ctx) error {
 		return fiber.NewError(http.StatusBadRequest, "invalid JSON body")
 	}
 
+	// "" diperlakukan sama dengan null supaya aturan isCurrent / endDate konsisten
+	req.EndDate = nilOrTrimmed(req.EndDate)
+	req.OrganizationID = nilOrTrimmed(req.OrganizationID)
+	if req.EmploymentType == "" {
+		req.EmploymentType = models.EmploymentFullTime
+	}
+
 	if err := validation.ValidateStruct(&req); err != nil {
 		return c.Status(http.StatusUnprocessableEntity).JSON(fiber.Map{
 			"error": fiber.Map{
@@ -176,7 +238,7 @@ func (h *AdminExperienceHandler) Create(c *fiber.Ctx) error {
 	}
 
 	var endDate *time.Time
-	if req.EndDate != nil && *req.EndDate != "" {
+	if req.EndDate != nil {
 		t, err := helpers.ParseDateStr(*req.EndDate)
 		if err != nil {
 			return fiber.NewError(http.StatusBadRequest, "invalid endDate format, expected YYYY-MM-DD")
@@ -184,14 +246,28 @@ func (h *AdminExperienceHandler) Create(c *fiber.Ctx) error {
 		endDate = &t
 	}
 
-	tagUUIDs, err := parseTagIDs(req.TagIDs)
+	tagUUIDs, err := parseUUIDs(req.TagIDs)
 	if err != nil {
 		return fiber.NewError(http.StatusBadRequest, "invalid tagIds")
 	}
 
+	projectUUIDs, err := parseUUIDs(req.ProjectIDs)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid projectIds")
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
+	org, err := findOrganization(h.db.WithContext(ctx), req.OrganizationID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return sendValidationError(c, map[string]string{"organizationId": "organisasi tidak ditemukan"})
+		}
+		log.Error().Err(err).Msg("failed to load organization for experience")
+		return fiber.NewError(http.StatusInternalServerError, "failed to load organization")
+	}
+
 	tx := h.db.WithContext(ctx).Begin()
 	defer func() {
 		if r := recover(); r != nil {
@@ -208,7 +284,10 @@ func (h *AdminExperienceHandler) Create(c *fiber.Ctx) error {
 		IsCurrent:   req.IsCurrent,
 		Description: req.Description,
 		SortOrder:   req.SortOrder,
+
+		EmploymentType: req.EmploymentType,
 	}
+	linkOrganization(&exp, org)
 
 	// Tags
 	if len(tagUUIDs) > 0 {
@@ -221,6 +300,17 @@ func (h *AdminExperienceHandler) Create(c *fiber.Ctx) error {
 		exp.Tags = tags
 	}
 
+	// Projects (many-to-many experience_projects)
+	if len(projectUUIDs) > 0 {
+		var projects []models.Project
+		if err := tx.Where("id IN ?", projectUUIDs).Find(&projects).Error; err != nil {
+			tx.Rollback()
+			log.Error().Err(err).Msg("failed to load projects for experience create")
+			return fiber.NewError(http.StatusInternalServerError, "failed to load projects")
+		}
+		exp.Projects = projects
+	}
+
 	if err := tx.Create(&exp).Error; err != nil {
 		tx.Rollback()
 		log.Error().Err(err).Msg("failed to create experience")
@@ -254,30 +344,35 @@ func (h *AdminExperienceHandler) Create(c *fiber.Ctx) error {
 		return fiber.NewError(http.StatusInternalServerError, "failed to create experience")
 	}
 
+	// response project ikut memuat experience ini
+	changes.Notify(changes.Experiences, changes.Projects)
+
+	// dicek setelah commit: query yang gagal di dalam tx akan membatalkan seluruh tx
+	warnings := experienceOverlapWarnings(h.db.WithContext(ctx), exp)
+
 	// reload
-	if err := h.db.WithContext(ctx).
-		Preload("Highlights", func(db *gorm.DB) *gorm.DB {
-			return db.Order("experience_highlights.sort_order ASC")
-		}).
-		Preload("Tags").
-		First(&exp, "id = ?", exp.ID).Error; err != nil {
+	if err := repository.ExperienceQuery(h.db.WithContext(ctx), repository.Projection{}).
+		First(&exp, "experiences.id = ?", exp.ID).Error; err != nil {
 
 		log.Error().Err(err).Msg("failed to reload created experience")
 	}
 
 	return c.Status(http.StatusCreated).JSON(fiber.Map{
-		"data": experienceToResponse(exp),
+		"data":     experienceToResponse(exp),
+		"warnings": warnings,
 	})
 }
 
 // PUT /api/v1/admin/experiences/:id
 // Admin Update Experience godoc
 // @Summary      Update experience
+// @Description  Same date rules and overlap warnings as create
 // @Tags         admin-experiences
 // @Security     BearerAuth
 // @Param        id      path string true "Experience ID"
 // @Param        payload body ExperienceUpdateRequest true "Update payload"
-// @Success      200     {object} ExperienceResponse
+// @Success      200     {object} ExperienceWriteResponse
+// @Failure      422     {object} ErrorResponse
 // @Router       /admin/experiences/{id} [put]
 func (h *AdminExperienceHandler) Update(c *fiber.Ctx) error {
 	idStr := c.Params("id")
@@ -292,6 +387,13 @@ func (h *AdminExperienceHandler) Update(c *fiber.Ctx) error {
 		return fiber.NewError(http.StatusBadRequest, "invalid JSON body")
 	}
 
+	// "" diperlakukan sama dengan null supaya aturan isCurrent / endDate konsisten
+	req.EndDate = nilOrTrimmed(req.EndDate)
+	req.OrganizationID = nilOrTrimmed(req.OrganizationID)
+	if req.EmploymentType == "" {
+		req.EmploymentType = models.EmploymentFullTime
+	}
+
 	if err := validation.ValidateStruct(&req); err != nil {
 		return c.Status(http.StatusUnprocessableEntity).JSON(fiber.Map{
 			"error": fiber.Map{
@@ -308,7 +410,7 @@ func (h *AdminExperienceHandler) Update(c *fiber.Ctx) error {
 	}
 
 	var endDate *time.Time
-	if req.EndDate != nil && *req.EndDate != "" {
+	if req.EndDate != nil {
 		t, err := helpers.ParseDateStr(*req.EndDate)
 		if err != nil {
 			return fiber.NewError(http.StatusBadRequest, "invalid endDate format, expected YYYY-MM-DD")
@@ -316,14 +418,28 @@ func (h *AdminExperienceHandler) Update(c *fiber.Ctx) error {
 		endDate = &t
 	}
 
-	tagUUIDs, err := parseTagIDs(req.TagIDs)
+	tagUUIDs, err := parseUUIDs(req.TagIDs)
 	if err != nil {
 		return fiber.NewError(http.StatusBadRequest, "invalid tagIds")
 	}
 
+	projectUUIDs, err := parseUUIDs(req.ProjectIDs)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid projectIds")
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
+	org, err := findOrganization(h.db.WithContext(ctx), req.OrganizationID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return sendValidationError(c, map[string]string{"organizationId": "organisasi tidak ditemukan"})
+		}
+		log.Error().Err(err).Msg("failed to load organization for experience")
+		return fiber.NewError(http.StatusInternalServerError, "failed to load organization")
+	}
+
 	tx := h.db.WithContext(ctx).Begin()
 	defer func() {
 		if r := recover(); r != nil {
@@ -351,6 +467,8 @@ func (h *AdminExperienceHandler) Update(c *fiber.Ctx) error {
 	exp.IsCurrent = req.IsCurrent
 	exp.Description = req.Description
 	exp.SortOrder = req.SortOrder
+	exp.EmploymentType = req.EmploymentType
+	linkOrganization(&exp, org)
 
 	if err := tx.Save(&exp).Error; err != nil {
 		tx.Rollback()
@@ -379,32 +497,32 @@ func (h *AdminExperienceHandler) Update(c *fiber.Ctx) error {
 		}
 	}
 
-	// update highlights: delete + reinsert
-	if err := tx.Where("experience_id = ?", exp.ID).Delete(&models.ExperienceHighlight{}).Error; err != nil {
+	// update projects
+	var projects []models.Project
+	if len(projectUUIDs) > 0 {
+		if err := tx.Where("id IN ?", projectUUIDs).Find(&projects).Error; err != nil {
+			tx.Rollback()
+			log.Error().Err(err).Msg("failed to load projects for experience update")
+			return fiber.NewError(http.StatusInternalServerError, "failed to update projects")
+		}
+	}
+	if err := tx.Model(&exp).Association("Projects").Replace(projects); err != nil {
 		tx.Rollback()
-		log.Error().Err(err).Msg("failed to delete old experience highlights")
-		return fiber.NewError(http.StatusInternalServerError, "failed to update highlights")
+		log.Error().Err(err).Msg("failed to update experience projects")
+		return fiber.NewError(http.StatusInternalServerError, "failed to update projects")
 	}
 
-	if len(req.Highlights) > 0 {
-		highs := make([]models.ExperienceHighlight, 0, len(req.Highlights))
-		for i, text := range req.Highlights {
-			if text == "" {
-				continue
-			}
-			highs = append(highs, models.ExperienceHighlight{
-				ExperienceID: exp.ID,
-				Text:         text,
-				SortOrder:    i,
-			})
-		}
-		if len(highs) > 0 {
-			if err := tx.Create(&highs).Error; err != nil {
-				tx.Rollback()
-				log.Error().Err(err).Msg("failed to create new experience highlights")
-				return fiber.NewError(http.StatusInternalServerError, "failed to update highlights")
-			}
-		}
+	// update highlights: baris lama dipakai ulang supaya ID tetap stabil
+	var oldHighlights []models.ExperienceHighlight
+	if err := tx.Where("experience_id = ?", exp.ID).Order("sort_order ASC").Find(&oldHighlights).Error; err != nil {
+		tx.Rollback()
+		log.Error().Err(err).Msg("failed to load experience highlights for update")
+		return fiber.NewError(http.StatusInternalServerError, "failed to update highlights")
+	}
+	if err := syncOrdered(tx, highlightAccess, exp.ID, oldHighlights, req.Highlights); err != nil {
+		tx.Rollback()
+		log.Error().Err(err).Msg("failed to sync experience highlights")
+		return fiber.NewError(http.StatusInternalServerError, "failed to update highlights")
 	}
 
 	if err := tx.Commit().Error; err != nil {
@@ -412,19 +530,22 @@ func (h *AdminExperienceHandler) Update(c *fiber.Ctx) error {
 		return fiber.NewError(http.StatusInternalServerError, "failed to update experience")
 	}
 
+	// response project ikut memuat experience ini
+	changes.Notify(changes.Experiences, changes.Projects)
+
+	// dicek setelah commit: query yang gagal di dalam tx akan membatalkan seluruh tx
+	warnings := experienceOverlapWarnings(h.db.WithContext(ctx), exp)
+
 	// reload
-	if err := h.db.WithContext(ctx).
-		Preload("Highlights", func(db *gorm.DB) *gorm.DB {
-			return db.Order("experience_highlights.sort_order ASC")
-		}).
-		Preload("Tags").
-		First(&exp, "id = ?", exp.ID).Error; err != nil {
+	if err := repository.ExperienceQuery(h.db.WithContext(ctx), repository.Projection{}).
+		First(&exp, "experiences.id = ?", exp.ID).Error; err != nil {
 
 		log.Error().Err(err).Msg("failed to reload updated experience")
 	}
 
 	return c.JSON(fiber.Map{
-		"data": experienceToResponse(exp),
+		"data":     experienceToResponse(exp),
+		"warnings": warnings,
 	})
 }
 
@@ -454,5 +575,7 @@ func (h *AdminExperienceHandler) Delete(c *fiber.Ctx) error {
 		return fiber.NewError(http.StatusInternalServerError, "failed to delete experience")
 	}
 
+	changes.Notify(changes.Experiences, changes.Projects)
+
 	return c.SendStatus(http.StatusNoContent)
 }
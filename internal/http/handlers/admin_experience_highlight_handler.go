@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/FauzanParanditha/portfolio-backend/internal/validation"
+	"github.com/gofiber/fiber/v2"
+)
+
+// Sub-resource highlights. ID highlight tetap sama saat diedit / diurutkan ulang.
+
+func highlightResp(h models.ExperienceHighlight) any { return highlightToResponse(h) }
+
+// POST /api/v1/admin/experiences/:id/highlights
+// Admin Create Experience Highlight godoc
+// @Summary      Add a highlight to an experience
+// @Description  Appended after the existing highlights
+// @Tags         admin-experiences
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id       path  string                      true  "Experience ID"
+// @Param        payload  body  ExperienceHighlightRequest  true  "Highlight payload"
+// @Success      201  {object}  ExperienceHighlightResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      422  {object}  ErrorResponse
+// @Router       /admin/experiences/{id}/highlights [post]
+func (h *AdminExperienceHandler) CreateHighlight(c *fiber.Ctx) error {
+	var req ExperienceHighlightRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid JSON body")
+	}
+
+	req.Text = strings.TrimSpace(req.Text)
+	if err := validation.ValidateStruct(&req); err != nil {
+		return sendValidationError(c, validation.ToFieldErrors(err))
+	}
+
+	return createChild(c, h.db, highlightRoute, highlightAccess, req.Text, highlightResp)
+}
+
+// PUT /api/v1/admin/experiences/:id/highlights/:childId
+// Admin Update Experience Highlight godoc
+// @Summary      Edit one experience highlight
+// @Tags         admin-experiences
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id       path  string                      true  "Experience ID"
+// @Param        childId  path  string                      true  "Highlight ID"
+// @Param        payload  body  ExperienceHighlightRequest  true  "Highlight payload"
+// @Success      200  {object}  ExperienceHighlightResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      422  {object}  ErrorResponse
+// @Router       /admin/experiences/{id}/highlights/{childId} [put]
+func (h *AdminExperienceHandler) UpdateHighlight(c *fiber.Ctx) error {
+	var req ExperienceHighlightRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid JSON body")
+	}
+
+	req.Text = strings.TrimSpace(req.Text)
+	if err := validation.ValidateStruct(&req); err != nil {
+		return sendValidationError(c, validation.ToFieldErrors(err))
+	}
+
+	return updateChild(c, h.db, highlightRoute, highlightAccess, req.Text, highlightResp)
+}
+
+// DELETE /api/v1/admin/experiences/:id/highlights/:childId
+// Admin Delete Experience Highlight godoc
+// @Summary      Delete one experience highlight
+// @Tags         admin-experiences
+// @Security     BearerAuth
+// @Param        id       path  string  true  "Experience ID"
+// @Param        childId  path  string  true  "Highlight ID"
+// @Success      204  "No Content"
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /admin/experiences/{id}/highlights/{childId} [delete]
+func (h *AdminExperienceHandler) DeleteHighlight(c *fiber.Ctx) error {
+	return deleteChild[models.ExperienceHighlight](c, h.db, highlightRoute)
+}
+
+// PATCH /api/v1/admin/experiences/:id/highlights/order
+// Admin Reorder Experience Highlights godoc
+// @Summary      Reorder experience highlights
+// @Description  ids must list every highlight of the experience exactly once, in the new order
+// @Tags         admin-experiences
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id       path  string          true  "Experience ID"
+// @Param        payload  body  ReorderRequest  true  "Highlight IDs in display order"
+// @Success      200  {array}   ExperienceHighlightResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      422  {object}  ErrorResponse
+// @Router       /admin/experiences/{id}/highlights/order [patch]
+func (h *AdminExperienceHandler) ReorderHighlights(c *fiber.Ctx) error {
+	return reorderChildren(c, h.db, highlightRoute, highlightResp)
+}
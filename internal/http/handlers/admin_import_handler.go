@@ -0,0 +1,690 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/changes"
+	"github.com/FauzanParanditha/portfolio-backend/internal/config"
+	"github.com/FauzanParanditha/portfolio-backend/internal/jsonresume"
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/FauzanParanditha/portfolio-backend/internal/repository"
+	"github.com/FauzanParanditha/portfolio-backend/internal/validation"
+	"github.com/gofiber/fiber/v2"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	importActionCreate    = "create"
+	importActionUpdate    = "update"
+	importActionUnchanged = "unchanged"
+)
+
+type AdminImportHandler struct {
+	db  *gorm.DB
+	cfg *config.Config
+}
+
+func NewAdminImportHandler(db *gorm.DB, cfg *config.Config) *AdminImportHandler {
+	return &AdminImportHandler{db: db, cfg: cfg}
+}
+
+// errImportSkip: keluar dari transaksi tanpa menulis apa-apa (dryRun / tidak ada perubahan / invalid)
+var errImportSkip = errors.New("import skipped")
+
+// Satu baris laporan import
+type ResumeImportChange struct {
+	Action string   `json:"action"` // create | update | unchanged
+	ID     *string  `json:"id,omitempty"`
+	Label  string   `json:"label"`
+	Fields []string `json:"fields,omitempty"` // field yang berubah (update)
+}
+
+type ResumeImportReport struct {
+	DryRun      bool                 `json:"dryRun"`
+	Experiences []ResumeImportChange `json:"experiences"`
+	Projects    []ResumeImportChange `json:"projects"`
+	Warnings    []string             `json:"warnings"`
+}
+
+type experienceImport struct {
+	change     ResumeImportChange
+	exp        models.Experience
+	existing   []models.ExperienceHighlight
+	highlights []string
+	isNew      bool
+}
+
+type projectImport struct {
+	change   ResumeImportChange
+	project  models.Project
+	existing []models.ProjectFeature
+	features []string
+	tags     []models.Tag
+	isNew    bool
+}
+
+// POST /api/v1/admin/import/json-resume?dryRun=true
+// Admin Import JSON Resume godoc
+// @Summary      Import JSON Resume
+// @Description  Create or update experiences (matched by company + position + start month) and projects (matched by title, existing slug, or a project URL on this site) from a jsonresume.org document, in one transaction. dryRun=true only reports the changes. basics & skills are ignored.
+// @Tags         admin-import
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        dryRun   query  bool               false  "Only report what would change"
+// @Param        payload  body   jsonresume.Resume  true   "JSON Resume document"
+// @Success      200  {object}  ResumeImportReport
+// @Failure      400  {object}  ErrorResponse
+// @Failure      422  {object}  ErrorResponse
+// @Router       /admin/import/json-resume [post]
+func (h *AdminImportHandler) JSONResume(c *fiber.Ctx) error {
+	dryRun := c.Query("dryRun") == "true"
+
+	var doc jsonresume.Resume
+	if err := c.BodyParser(&doc); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid JSON body")
+	}
+
+	if err := validation.ValidateStruct(&doc); err != nil {
+		return sendValidationError(c, validation.ToFieldErrors(err))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	report := ResumeImportReport{
+		DryRun:      dryRun,
+		Experiences: []ResumeImportChange{},
+		Projects:    []ResumeImportChange{},
+		Warnings:    []string{},
+	}
+
+	// plan & apply di transaksi yang sama: data yang dibandingkan = data yang ditimpa
+	var fieldErrors map[string]string
+	err := h.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// baris yang dibandingkan dikunci sampai commit
+		if !dryRun {
+			tx = tx.Clauses(clause.Locking{Strength: "UPDATE"}).Session(&gorm.Session{})
+		}
+
+		expPlans, expErrors, err := h.planExperiences(tx, doc.Work)
+		if err != nil {
+			return fmt.Errorf("plan work: %w", err)
+		}
+
+		projPlans, projErrors, warnings, err := h.planProjects(ctx, tx, doc.Projects)
+		if err != nil {
+			return fmt.Errorf("plan projects: %w", err)
+		}
+
+		fieldErrors = expErrors
+		for k, v := range projErrors {
+			fieldErrors[k] = v
+		}
+		if len(fieldErrors) > 0 {
+			return errImportSkip
+		}
+
+		report.Warnings = append(report.Warnings, warnings...)
+		changed := false
+		for _, p := range expPlans {
+			report.Experiences = append(report.Experiences, p.change)
+			changed = changed || p.change.Action != importActionUnchanged
+		}
+		for _, p := range projPlans {
+			report.Projects = append(report.Projects, p.change)
+			changed = changed || p.change.Action != importActionUnchanged
+		}
+
+		if dryRun || !changed {
+			return errImportSkip
+		}
+
+		tx = tx.Session(&gorm.Session{NewDB: true})
+		for i := range expPlans {
+			if err := applyExperienceImport(tx, &expPlans[i]); err != nil {
+				return err
+			}
+			report.Experiences[i] = expPlans[i].change
+		}
+		for i := range projPlans {
+			if err := applyProjectImport(tx, &projPlans[i]); err != nil {
+				return err
+			}
+			report.Projects[i] = projPlans[i].change
+		}
+		return nil
+	})
+
+	switch {
+	case len(fieldErrors) > 0:
+		return sendValidationError(c, fieldErrors)
+	case errors.Is(err, errImportSkip):
+		return c.JSON(fiber.Map{"data": report})
+	case err != nil:
+		log.Error().Err(err).Msg("failed to import json resume")
+		return fiber.NewError(http.StatusInternalServerError, "failed to import resume")
+	}
+
+	changes.Notify(changes.Experiences, changes.Projects)
+
+	return c.JSON(fiber.Map{"data": report})
+}
+
+// ---------------------------------------------------------
+// Experience (work)
+// ---------------------------------------------------------
+
+func experienceImportKey(company, title string, start time.Time) string {
+	return strings.ToLower(strings.TrimSpace(company)) + "|" +
+		strings.ToLower(strings.TrimSpace(title)) + "|" +
+		start.Format("2006-01")
+}
+
+func (h *AdminImportHandler) planExperiences(tx *gorm.DB, work []jsonresume.Work) ([]experienceImport, map[string]string, error) {
+	var existing []models.Experience
+	if err := tx.
+		Preload("Highlights", func(db *gorm.DB) *gorm.DB {
+			return db.Order("experience_highlights.sort_order ASC")
+		}).
+		Find(&existing).Error; err != nil {
+		return nil, nil, err
+	}
+
+	byKey := make(map[string]models.Experience, len(existing))
+	for _, e := range existing {
+		byKey[experienceImportKey(e.Company, e.Title, e.StartDate)] = e
+	}
+
+	// experience baru di-link ke organisasi yang namanya sama (case-insensitive)
+	var orgs []models.Organization
+	if err := tx.Session(&gorm.Session{NewDB: true}).Find(&orgs).Error; err != nil {
+		return nil, nil, err
+	}
+	orgByName := make(map[string]*models.Organization, len(orgs))
+	for i := range orgs {
+		orgByName[strings.ToLower(orgs[i].Name)] = &orgs[i]
+	}
+
+	plans := make([]experienceImport, 0, len(work))
+	fieldErrors := map[string]string{}
+	seen := map[string]bool{}
+
+	for i, w := range work {
+		start, err := parseDateBound(w.StartDate, false)
+		if err != nil {
+			fieldErrors[fmt.Sprintf("work[%d].startDate", i)] = "format tanggal tidak valid"
+			continue
+		}
+
+		key := experienceImportKey(w.Name, w.Position, start)
+		if seen[key] {
+			fieldErrors[fmt.Sprintf("work[%d]", i)] = "duplikat experience " + key
+			continue
+		}
+		seen[key] = true
+
+		var end *time.Time
+		if w.EndDate != "" {
+			t, err := parseDateBound(w.EndDate, true)
+			if err != nil {
+				fieldErrors[fmt.Sprintf("work[%d].endDate", i)] = "format tanggal tidak valid"
+				continue
+			}
+			if t.Before(start) {
+				fieldErrors[fmt.Sprintf("work[%d].endDate", i)] = "tidak boleh sebelum startDate"
+				continue
+			}
+			end = &t
+		}
+
+		highlights := nonEmpty(w.Highlights)
+		label := strings.TrimSpace(w.Position) + " @ " + strings.TrimSpace(w.Name)
+
+		cur, ok := byKey[key]
+		if !ok {
+			plans = append(plans, experienceImport{
+				change: ResumeImportChange{Action: importActionCreate, Label: label},
+				exp: models.Experience{
+					Title:       strings.TrimSpace(w.Position),
+					Company:     strings.TrimSpace(w.Name),
+					Location:    w.Location,
+					StartDate:   start,
+					EndDate:     end,
+					IsCurrent:   end == nil,
+					Description: w.Summary,
+				},
+				highlights: highlights,
+				isNew:      true,
+			})
+			linkOrganization(&plans[len(plans)-1].exp, orgByName[strings.ToLower(strings.TrimSpace(w.Name))])
+			continue
+		}
+
+		// field opsional yang kosong di dokumen tidak menimpa data lama
+		var fields []string
+		if !sameDate(&cur.StartDate, &start) {
+			cur.StartDate = start
+			fields = append(fields, "startDate")
+		}
+		if !sameDate(cur.EndDate, end) || cur.IsCurrent != (end == nil) {
+			cur.EndDate = end
+			cur.IsCurrent = end == nil
+			fields = append(fields, "endDate")
+		}
+		if w.Location != "" && w.Location != cur.Location {
+			cur.Location = w.Location
+			fields = append(fields, "location")
+		}
+		if w.Summary != "" && w.Summary != cur.Description {
+			cur.Description = w.Summary
+			fields = append(fields, "description")
+		}
+
+		oldHighlights := make([]string, 0, len(cur.Highlights))
+		for _, hl := range cur.Highlights {
+			oldHighlights = append(oldHighlights, hl.Text)
+		}
+		if w.Highlights == nil {
+			highlights = oldHighlights
+		} else if !equalStrings(oldHighlights, highlights) {
+			fields = append(fields, "highlights")
+		}
+
+		id := cur.ID.String()
+		change := ResumeImportChange{Action: importActionUnchanged, ID: &id, Label: label}
+		if len(fields) > 0 {
+			change.Action = importActionUpdate
+			change.Fields = fields
+		}
+
+		plans = append(plans, experienceImport{change: change, exp: cur, existing: cur.Highlights, highlights: highlights})
+	}
+
+	return plans, fieldErrors, nil
+}
+
+func applyExperienceImport(tx *gorm.DB, p *experienceImport) error {
+	switch {
+	case p.isNew:
+		if err := tx.Omit("Highlights", "Tags").Create(&p.exp).Error; err != nil {
+			return err
+		}
+		id := p.exp.ID.String()
+		p.change.ID = &id
+	case p.change.Action == importActionUpdate:
+		if err := tx.Omit("Highlights", "Tags").Save(&p.exp).Error; err != nil {
+			return err
+		}
+	default:
+		return nil
+	}
+
+	return syncOrdered(tx, highlightAccess, p.exp.ID, p.existing, p.highlights)
+}
+
+// ---------------------------------------------------------
+// Project
+// ---------------------------------------------------------
+
+func (h *AdminImportHandler) planProjects(ctx context.Context, tx *gorm.DB, docs []jsonresume.Project) ([]projectImport, map[string]string, []string, error) {
+	var existing []models.Project
+	if err := tx.
+		Preload("Features", func(db *gorm.DB) *gorm.DB {
+			return db.Order("project_features.sort_order ASC")
+		}).
+		Preload("Tags").
+		Find(&existing).Error; err != nil {
+		return nil, nil, nil, err
+	}
+
+	bySlug := make(map[string]*models.Project, len(existing))
+	byTitle := make(map[string]*models.Project, len(existing))
+	for i := range existing {
+		bySlug[existing[i].Slug] = &existing[i]
+		byTitle[strings.ToLower(strings.TrimSpace(existing[i].Title))] = &existing[i]
+	}
+
+	var allTags []models.Tag
+	if err := tx.Session(&gorm.Session{NewDB: true}).Find(&allTags).Error; err != nil {
+		return nil, nil, nil, err
+	}
+	tagsByName := make(map[string]models.Tag, len(allTags))
+	for _, t := range allTags {
+		tagsByName[strings.ToLower(t.Name)] = t
+	}
+
+	schemas := repository.NewProjectSchemaRepository(tx.Session(&gorm.Session{NewDB: true}))
+
+	plans := make([]projectImport, 0, len(docs))
+	fieldErrors := map[string]string{}
+	var warnings []string
+	seen := map[string]bool{}
+
+	for i, d := range docs {
+		name := strings.TrimSpace(d.Name)
+
+		// url ke halaman project di site ini (hasil export resume.json) → slug project tsb
+		ownSlug, ownURL := h.ownProjectSlug(d.URL)
+
+		// cocokkan dengan project yang ada: slug dari URL, judul, lalu slug yang sudah ada
+		cur, ok := bySlug[ownSlug]
+		if !ok {
+			cur, ok = byTitle[strings.ToLower(name)]
+		}
+		if !ok {
+			cur, ok = bySlug[slugify(name)]
+		}
+
+		slug := slugify(name)
+		if ok {
+			slug = cur.Slug
+		} else if slug == "" {
+			fieldErrors[fmt.Sprintf("projects[%d].name", i)] = "nama tidak bisa dijadikan slug"
+			continue
+		}
+		if seen[slug] {
+			fieldErrors[fmt.Sprintf("projects[%d].name", i)] = "duplikat project " + slug
+			continue
+		}
+		seen[slug] = true
+
+		timeline, err := importTimeline(d.StartDate, d.EndDate)
+		if err != nil {
+			fieldErrors[fmt.Sprintf("projects[%d].startDate", i)] = "format tanggal tidak valid"
+			continue
+		}
+
+		var tags []models.Tag
+		for _, kw := range d.Keywords {
+			t, ok := tagsByName[strings.ToLower(strings.TrimSpace(kw))]
+			if !ok {
+				warnings = append(warnings, fmt.Sprintf("project %q: keyword %q tidak cocok dengan tag manapun, dilewati", d.Name, kw))
+				continue
+			}
+			tags = append(tags, t)
+		}
+
+		// URL ke site sendiri bukan demo
+		var demoURL, repoURL *string
+		if d.URL != "" && !ownURL {
+			link := d.URL
+			if u, err := url.Parse(link); err == nil && strings.Contains(u.Host, "github.com") {
+				repoURL = &link
+			} else {
+				demoURL = &link
+			}
+		}
+
+		features := nonEmpty(d.Highlights)
+		role := ""
+		if len(d.Roles) > 0 {
+			role = d.Roles[0]
+		}
+
+		if !ok {
+			p := models.Project{
+				Title:     name,
+				Slug:      slug,
+				ShortDesc: d.Description,
+				Category:  d.Type,
+				Role:      role,
+				Timeline:  timeline,
+				DemoURL:   demoURL,
+				RepoURL:   repoURL,
+			}
+
+			// technicalDetails kosong harus tetap lolos schema kategori
+			details, errs, err := prepareTechnicalDetails(ctx, schemas, p.Category, nil)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			if len(errs) > 0 {
+				addImportErrors(fieldErrors, i, errs)
+				continue
+			}
+			p.TechnicalDetails = details
+
+			warnings = append(warnings, fmt.Sprintf("project %q dibuat tanpa cover image", d.Name))
+			plans = append(plans, projectImport{
+				change:   ResumeImportChange{Action: importActionCreate, Label: d.Name},
+				project:  p,
+				features: features,
+				tags:     tags,
+				isNew:    true,
+			})
+			continue
+		}
+
+		var fields []string
+		if name != cur.Title {
+			cur.Title = name
+			fields = append(fields, "title")
+		}
+		if d.Description != "" && d.Description != cur.ShortDesc {
+			cur.ShortDesc = d.Description
+			fields = append(fields, "shortDesc")
+		}
+		if d.Type != "" && d.Type != cur.Category {
+			cur.Category = d.Type
+			fields = append(fields, "category")
+
+			// kategori baru bisa punya schema lain untuk technicalDetails yang sudah ada
+			var details map[string]any
+			if len(cur.TechnicalDetails) > 0 {
+				if err := json.Unmarshal(cur.TechnicalDetails, &details); err != nil {
+					return nil, nil, nil, err
+				}
+			}
+			_, errs, err := prepareTechnicalDetails(ctx, schemas, cur.Category, details)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			if len(errs) > 0 {
+				addImportErrors(fieldErrors, i, errs)
+				continue
+			}
+		}
+		if role != "" && role != cur.Role {
+			cur.Role = role
+			fields = append(fields, "role")
+		}
+		if timeline != "" && timeline != cur.Timeline {
+			cur.Timeline = timeline
+			fields = append(fields, "timeline")
+		}
+		if repoURL != nil && (cur.RepoURL == nil || *cur.RepoURL != *repoURL) {
+			cur.RepoURL = repoURL
+			fields = append(fields, "repoUrl")
+		}
+		if demoURL != nil && (cur.DemoURL == nil || *cur.DemoURL != *demoURL) {
+			cur.DemoURL = demoURL
+			fields = append(fields, "demoUrl")
+		}
+
+		oldFeatures := make([]string, 0, len(cur.Features))
+		for _, f := range cur.Features {
+			oldFeatures = append(oldFeatures, f.Text)
+		}
+		if d.Highlights == nil {
+			features = oldFeatures
+		} else if !equalStrings(oldFeatures, features) {
+			fields = append(fields, "features")
+		}
+
+		switch {
+		case d.Keywords == nil:
+			tags = cur.Tags
+		case len(d.Keywords) > 0 && len(tags) == 0:
+			// tidak ada keyword yang cocok: jangan hapus tag yang sudah ada
+			tags = cur.Tags
+			if len(cur.Tags) > 0 {
+				warnings = append(warnings, fmt.Sprintf("project %q: tidak ada keyword yang cocok dengan tag, tag lama dipertahankan", d.Name))
+			}
+		case !sameTags(cur.Tags, tags):
+			fields = append(fields, "tags")
+		}
+
+		id := cur.ID.String()
+		change := ResumeImportChange{Action: importActionUnchanged, ID: &id, Label: d.Name}
+		if len(fields) > 0 {
+			change.Action = importActionUpdate
+			change.Fields = fields
+		}
+
+		plans = append(plans, projectImport{change: change, project: *cur, existing: cur.Features, features: features, tags: tags})
+	}
+
+	return plans, fieldErrors, warnings, nil
+}
+
+// ownProjectSlug: apakah link mengarah ke SiteBaseURL, plus slug kalau bentuknya /projects/<slug>
+func (h *AdminImportHandler) ownProjectSlug(link string) (string, bool) {
+	if link == "" || h.cfg.SiteBaseURL == "" {
+		return "", false
+	}
+
+	u, err := url.Parse(link)
+	if err != nil {
+		return "", false
+	}
+	base, err := url.Parse(h.cfg.SiteBaseURL)
+	if err != nil || !strings.EqualFold(u.Host, base.Host) {
+		return "", false
+	}
+
+	basePath := strings.TrimRight(base.Path, "/")
+	if u.Path != basePath && !strings.HasPrefix(u.Path, basePath+"/") {
+		return "", false
+	}
+
+	rest := strings.Trim(strings.TrimPrefix(u.Path, basePath), "/")
+	if slug, ok := strings.CutPrefix(rest, "projects/"); ok && slug != "" && !strings.Contains(slug, "/") {
+		return slug, true
+	}
+	return "", true
+}
+
+// addImportErrors: error technicalDetails.* → projects[i].technicalDetails.*
+func addImportErrors(fieldErrors map[string]string, i int, errs map[string]string) {
+	for k, v := range errs {
+		fieldErrors[fmt.Sprintf("projects[%d].%s", i, k)] = v
+	}
+}
+
+func applyProjectImport(tx *gorm.DB, p *projectImport) error {
+	switch {
+	case p.isNew:
+		if err := tx.Omit("Features", "Tags", "Screenshots", "Experiences", "Client").Create(&p.project).Error; err != nil {
+			return err
+		}
+		id := p.project.ID.String()
+		p.change.ID = &id
+	case p.change.Action == importActionUpdate:
+		if err := tx.Omit("Features", "Tags", "Screenshots", "Experiences", "Client").Save(&p.project).Error; err != nil {
+			return err
+		}
+	default:
+		return nil
+	}
+
+	if len(p.tags) > 0 {
+		if err := tx.Model(&p.project).Association("Tags").Replace(&p.tags); err != nil {
+			return err
+		}
+	} else if !p.isNew {
+		if err := tx.Model(&p.project).Association("Tags").Clear(); err != nil {
+			return err
+		}
+	}
+
+	return syncOrdered(tx, featureAccess, p.project.ID, p.existing, p.features)
+}
+
+// ---------------------------------------------------------
+// helpers
+// ---------------------------------------------------------
+
+var slugInvalid = regexp.MustCompile(`[^a-z0-9]+`)
+
+func slugify(s string) string {
+	return strings.Trim(slugInvalid.ReplaceAllString(strings.ToLower(s), "-"), "-")
+}
+
+// importTimeline: "2023-01 – 2023-06" / "2023-01 – Present"
+func importTimeline(start, end string) (string, error) {
+	if start == "" {
+		return "", nil
+	}
+
+	s, err := parseDateBound(start, false)
+	if err != nil {
+		return "", err
+	}
+
+	to := "Present"
+	if end != "" {
+		e, err := parseDateBound(end, true)
+		if err != nil {
+			return "", err
+		}
+		to = e.Format("2006-01")
+	}
+
+	return s.Format("2006-01") + " – " + to, nil
+}
+
+func nonEmpty(items []string) []string {
+	out := make([]string, 0, len(items))
+	for _, s := range items {
+		if s = strings.TrimSpace(s); s != "" {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func sameDate(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Format("2006-01-02") == b.Format("2006-01-02")
+}
+
+func sameTags(a, b []models.Tag) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	ids := make(map[string]bool, len(a))
+	for _, t := range a {
+		ids[t.ID.String()] = true
+	}
+	for _, t := range b {
+		if !ids[t.ID.String()] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/repository"
+	"github.com/gofiber/fiber/v2"
+	"github.com/rs/zerolog/log"
+)
+
+type AdminLinkHealthHandler struct {
+	repo repository.LinkCheckRepository
+}
+
+func NewAdminLinkHealthHandler(repo repository.LinkCheckRepository) *AdminLinkHealthHandler {
+	return &AdminLinkHealthHandler{repo: repo}
+}
+
+// GET /api/v1/admin/link-health?broken=true&kind=demo
+// Admin Link Health godoc
+// @Summary      Link health of project URLs
+// @Description  Latest result of the background checker for demo, repo, cover and screenshot URLs
+// @Tags         admin-link-health
+// @Security     BearerAuth
+// @Produce      json
+// @Param        broken  query  bool    false "Only broken links"
+// @Param        kind    query  string  false "demo | repo | cover | screenshot"
+// @Success      200  {array}   LinkHealthResponse
+// @Failure      401  {object}  ErrorResponse
+// @Router       /admin/link-health [get]
+func (h *AdminLinkHealthHandler) List(c *fiber.Ctx) error {
+	brokenOnly := c.Query("broken") == "true"
+	kind := c.Query("kind")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	checks, err := h.repo.List(ctx, repository.LinkCheckListParams{
+		BrokenOnly: brokenOnly,
+		Kind:       kind,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list link checks")
+		return fiber.NewError(http.StatusInternalServerError, "failed to fetch link health")
+	}
+
+	var broken int
+	resp := make([]LinkHealthResponse, 0, len(checks))
+	for _, lc := range checks {
+		if lc.IsBroken {
+			broken++
+		}
+		resp = append(resp, linkCheckToResponse(lc))
+	}
+
+	return c.JSON(fiber.Map{
+		"data": resp,
+		"meta": fiber.Map{
+			"total":  len(resp),
+			"broken": broken,
+			"kind":   kind,
+		},
+	})
+}
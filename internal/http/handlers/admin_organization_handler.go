@@ -0,0 +1,594 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/changes"
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/FauzanParanditha/portfolio-backend/internal/validation"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+type AdminOrganizationHandler struct {
+	db *gorm.DB
+}
+
+func NewAdminOrganizationHandler(db *gorm.DB) *AdminOrganizationHandler {
+	return &AdminOrganizationHandler{db: db}
+}
+
+// organisasi + jumlah pemakaian (untuk list admin & kandidat merge)
+type organizationWithCounts struct {
+	models.Organization `gorm:"embedded"`
+	ExperienceCount     int64
+	ProjectCount        int64
+}
+
+const organizationCountColumns = `organizations.*,
+	(SELECT count(*) FROM experiences e WHERE e.organization_id = organizations.id) AS experience_count,
+	(SELECT count(*) FROM projects p WHERE p.client_id = organizations.id) AS project_count`
+
+func (o organizationWithCounts) response() OrganizationResponse {
+	resp := organizationToResponse(o.Organization)
+	resp.ExperienceCount = &o.ExperienceCount
+	resp.ProjectCount = &o.ProjectCount
+	return resp
+}
+
+// Helper: load organisasi dari id opsional (nil → nil, nil). ID tidak ada → gorm.ErrRecordNotFound.
+// Dipakai experience (organizationId) & project (clientId).
+func findOrganization(db *gorm.DB, idStr *string) (*models.Organization, error) {
+	if idStr == nil {
+		return nil, nil
+	}
+	id, err := uuid.Parse(*idStr)
+	if err != nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	var org models.Organization
+	if err := db.First(&org, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &org, nil
+}
+
+func organizationID(org *models.Organization) *uuid.UUID {
+	if org == nil {
+		return nil
+	}
+	return &org.ID
+}
+
+// Helper: link experience ke organisasi. Nama company ikut ejaan organisasi,
+// location kosong diisi dari organisasi.
+func linkOrganization(exp *models.Experience, org *models.Organization) {
+	exp.OrganizationID = organizationID(org)
+	if org == nil {
+		return
+	}
+	exp.Company = org.Name
+	if exp.Location == "" {
+		exp.Location = org.Location
+	}
+}
+
+// Helper: cek nama (case-insensitive) / slug sudah dipakai organisasi lain
+func (h *AdminOrganizationHandler) conflict(ctx context.Context, name, slug string, exceptID *uuid.UUID) (string, error) {
+	q := h.db.WithContext(ctx).Model(&models.Organization{}).
+		Where(h.db.Where("lower(name) = lower(?)", name).Or("slug = ?", slug))
+	if exceptID != nil {
+		q = q.Where("id <> ?", *exceptID)
+	}
+
+	var other models.Organization
+	err := q.Take(&other).Error
+	if err == gorm.ErrRecordNotFound {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if other.Slug == slug {
+		return "slug already used by another organization", nil
+	}
+	return "organization with this name already exists", nil
+}
+
+// Helper: pre-check di atas hanya untuk pesan yang jelas; yang menjamin unik tetap
+// index DB. Insert / update yang kalah balapan dapat unique violation (23505) → 409.
+func isUniqueViolation(err error) bool {
+	var pgErr interface{ SQLState() string }
+	return errors.As(err, &pgErr) && pgErr.SQLState() == "23505"
+}
+
+// Helper: isi field dari request. Slug kosong → dari name.
+func applyOrganizationRequest(o *models.Organization, req OrganizationCreateRequest) map[string]string {
+	name := strings.TrimSpace(req.Name)
+
+	slug := strings.TrimSpace(req.Slug)
+	if slug == "" {
+		slug = name
+	}
+	slug = slugify(slug)
+	if slug == "" {
+		return map[string]string{"slug": "harus memuat huruf atau angka"}
+	}
+
+	o.Name = name
+	o.Slug = slug
+	o.LogoURL = req.LogoURL
+	o.WebsiteURL = req.WebsiteURL
+	o.Industry = req.Industry
+	o.Location = req.Location
+
+	return nil
+}
+
+// GET /api/v1/admin/organizations
+// Admin List Organizations godoc
+// @Summary      List organizations
+// @Description  Includes experienceCount / projectCount so duplicates can be spotted before merging
+// @Tags         admin-organizations
+// @Security     BearerAuth
+// @Produce      json
+// @Param        q      query  string  false  "Search name / industry / location"
+// @Param        page   query  int     false  "Page"
+// @Param        limit  query  int     false  "Limit"
+// @Success      200  {array}   OrganizationResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /admin/organizations [get]
+func (h *AdminOrganizationHandler) List(c *fiber.Ctx) error {
+	q := c.Query("q")
+
+	page, err := strconv.Atoi(c.Query("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	limit, err := strconv.Atoi(c.Query("limit", "20"))
+	if err != nil || limit < 1 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	offset := (page - 1) * limit
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	qb := h.db.WithContext(ctx).Model(&models.Organization{})
+
+	if q != "" {
+		like := "%" + q + "%"
+		qb = qb.Where(
+			h.db.Where("organizations.name ILIKE ?", like).
+				Or("organizations.industry ILIKE ?", like).
+				Or("organizations.location ILIKE ?", like),
+		)
+	}
+
+	var total int64
+	if err := qb.Count(&total).Error; err != nil {
+		log.Error().Err(err).Msg("failed to count organizations")
+		return fiber.NewError(http.StatusInternalServerError, "failed to fetch organizations")
+	}
+
+	var items []organizationWithCounts
+	if err := qb.
+		Select(organizationCountColumns).
+		Order("lower(organizations.name) ASC").
+		Limit(limit).
+		Offset(offset).
+		Scan(&items).Error; err != nil {
+
+		log.Error().Err(err).Msg("failed to list organizations")
+		return fiber.NewError(http.StatusInternalServerError, "failed to fetch organizations")
+	}
+
+	resp := make([]OrganizationResponse, 0, len(items))
+	for _, o := range items {
+		resp = append(resp, o.response())
+	}
+
+	return c.JSON(fiber.Map{
+		"data": resp,
+		"meta": PaginationMeta{
+			Page:    page,
+			Limit:   limit,
+			Total:   &total,
+			HasMore: int64(page*limit) < total,
+			Query:   q,
+		},
+	})
+}
+
+// GET /api/v1/admin/organizations/:id
+// Admin Get Organization godoc
+// @Summary      Get organization
+// @Tags         admin-organizations
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id   path  string  true  "Organization ID"
+// @Success      200  {object}  OrganizationResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /admin/organizations/{id} [get]
+func (h *AdminOrganizationHandler) GetByID(c *fiber.Ctx) error {
+	idStr := c.Params("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid organization ID")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var items []organizationWithCounts
+	if err := h.db.WithContext(ctx).
+		Model(&models.Organization{}).
+		Select(organizationCountColumns).
+		Where("organizations.id = ?", id).
+		Limit(1).
+		Scan(&items).Error; err != nil {
+
+		log.Error().Err(err).Str("id", idStr).Msg("failed to get organization")
+		return fiber.NewError(http.StatusInternalServerError, "failed to fetch organization")
+	}
+	if len(items) == 0 {
+		return fiber.NewError(http.StatusNotFound, "organization not found")
+	}
+
+	return c.JSON(fiber.Map{
+		"data": items[0].response(),
+	})
+}
+
+// POST /api/v1/admin/organizations
+// Admin Create Organization godoc
+// @Summary      Create organization
+// @Description  slug is derived from name when empty. Names are unique case-insensitively.
+// @Tags         admin-organizations
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        payload  body  OrganizationCreateRequest  true  "Organization payload"
+// @Success      201  {object}  OrganizationResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      409  {object}  ErrorResponse
+// @Failure      422  {object}  ErrorResponse
+// @Router       /admin/organizations [post]
+func (h *AdminOrganizationHandler) Create(c *fiber.Ctx) error {
+	var req OrganizationCreateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid JSON body")
+	}
+
+	if err := validation.ValidateStruct(&req); err != nil {
+		return sendValidationError(c, validation.ToFieldErrors(err))
+	}
+
+	var org models.Organization
+	if fieldErrors := applyOrganizationRequest(&org, req); len(fieldErrors) > 0 {
+		return sendValidationError(c, fieldErrors)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	msg, err := h.conflict(ctx, org.Name, org.Slug, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to check organization conflict")
+		return fiber.NewError(http.StatusInternalServerError, "failed to create organization")
+	}
+	if msg != "" {
+		return fiber.NewError(http.StatusConflict, msg)
+	}
+
+	if err := h.db.WithContext(ctx).Create(&org).Error; err != nil {
+		if isUniqueViolation(err) {
+			return fiber.NewError(http.StatusConflict, "organization with this name or slug already exists")
+		}
+		log.Error().Err(err).Msg("failed to create organization")
+		return fiber.NewError(http.StatusInternalServerError, "failed to create organization")
+	}
+
+	return c.Status(http.StatusCreated).JSON(fiber.Map{
+		"data": organizationToResponse(org),
+	})
+}
+
+// PUT /api/v1/admin/organizations/:id
+// Admin Update Organization godoc
+// @Summary      Update organization
+// @Description  Renaming also updates the company name of linked experiences
+// @Tags         admin-organizations
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id       path  string                     true  "Organization ID"
+// @Param        payload  body  OrganizationUpdateRequest  true  "Organization payload"
+// @Success      200  {object}  OrganizationResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      409  {object}  ErrorResponse
+// @Failure      422  {object}  ErrorResponse
+// @Router       /admin/organizations/{id} [put]
+func (h *AdminOrganizationHandler) Update(c *fiber.Ctx) error {
+	idStr := c.Params("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid organization ID")
+	}
+
+	var req OrganizationUpdateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid JSON body")
+	}
+
+	if err := validation.ValidateStruct(&req); err != nil {
+		return sendValidationError(c, validation.ToFieldErrors(err))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var org models.Organization
+	if err := h.db.WithContext(ctx).First(&org, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return fiber.NewError(http.StatusNotFound, "organization not found")
+		}
+		log.Error().Err(err).Str("id", idStr).Msg("failed to load organization for update")
+		return fiber.NewError(http.StatusInternalServerError, "failed to update organization")
+	}
+
+	if fieldErrors := applyOrganizationRequest(&org, req); len(fieldErrors) > 0 {
+		return sendValidationError(c, fieldErrors)
+	}
+
+	msg, err := h.conflict(ctx, org.Name, org.Slug, &org.ID)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to check organization conflict")
+		return fiber.NewError(http.StatusInternalServerError, "failed to update organization")
+	}
+	if msg != "" {
+		return fiber.NewError(http.StatusConflict, msg)
+	}
+
+	tx := h.db.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := tx.Save(&org).Error; err != nil {
+		tx.Rollback()
+		if isUniqueViolation(err) {
+			return fiber.NewError(http.StatusConflict, "organization with this name or slug already exists")
+		}
+		log.Error().Err(err).Msg("failed to update organization")
+		return fiber.NewError(http.StatusInternalServerError, "failed to update organization")
+	}
+
+	// company di experience = nama organisasi
+	if err := tx.Model(&models.Experience{}).
+		Where("organization_id = ? AND company <> ?", org.ID, org.Name).
+		Update("company", org.Name).Error; err != nil {
+
+		tx.Rollback()
+		log.Error().Err(err).Msg("failed to sync experience company names")
+		return fiber.NewError(http.StatusInternalServerError, "failed to update organization")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		log.Error().Err(err).Msg("failed to commit organization update")
+		return fiber.NewError(http.StatusInternalServerError, "failed to update organization")
+	}
+
+	// organisasi ikut di response experience & project
+	changes.Notify(changes.Experiences, changes.Projects)
+
+	return c.JSON(fiber.Map{
+		"data": organizationToResponse(org),
+	})
+}
+
+// DELETE /api/v1/admin/organizations/:id
+// Admin Delete Organization godoc
+// @Summary      Delete organization
+// @Description  Linked experiences keep their company text; projects lose their client
+// @Tags         admin-organizations
+// @Security     BearerAuth
+// @Param        id   path  string  true  "Organization ID"
+// @Success      204  "No Content"
+// @Failure      404  {object}  ErrorResponse
+// @Router       /admin/organizations/{id} [delete]
+func (h *AdminOrganizationHandler) Delete(c *fiber.Ctx) error {
+	idStr := c.Params("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid organization ID")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// FK ON DELETE SET NULL di experiences & projects
+	res := h.db.WithContext(ctx).
+		Where("id = ?", id).
+		Delete(&models.Organization{})
+	if res.Error != nil {
+		log.Error().Err(res.Error).Str("id", idStr).Msg("failed to delete organization")
+		return fiber.NewError(http.StatusInternalServerError, "failed to delete organization")
+	}
+	if res.RowsAffected == 0 {
+		return fiber.NewError(http.StatusNotFound, "organization not found")
+	}
+
+	changes.Notify(changes.Experiences, changes.Projects)
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// POST /api/v1/admin/organizations/:id/merge
+// Admin Merge Organizations godoc
+// @Summary      Merge duplicate organizations into this one
+// @Description  Moves every experience and project of sourceIds to the target organization (experience company names follow the target), fills empty target fields (logo, website, industry, location) from the sources, then deletes the sources. All or nothing.
+// @Tags         admin-organizations
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id       path  string                    true  "Target organization ID"
+// @Param        payload  body  OrganizationMergeRequest  true  "Organizations to merge"
+// @Success      200  {object}  OrganizationMergeResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      422  {object}  ErrorResponse
+// @Router       /admin/organizations/{id}/merge [post]
+func (h *AdminOrganizationHandler) Merge(c *fiber.Ctx) error {
+	idStr := c.Params("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid organization ID")
+	}
+
+	var req OrganizationMergeRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid JSON body")
+	}
+
+	if err := validation.ValidateStruct(&req); err != nil {
+		return sendValidationError(c, validation.ToFieldErrors(err))
+	}
+
+	sourceIDs, err := parseUUIDs(req.SourceIDs)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid sourceIds")
+	}
+
+	// dedupe, target tidak boleh ikut di-merge ke dirinya sendiri
+	seen := map[uuid.UUID]bool{}
+	unique := make([]uuid.UUID, 0, len(sourceIDs))
+	for _, sid := range sourceIDs {
+		if sid == id {
+			return sendValidationError(c, map[string]string{"sourceIds": "tidak boleh memuat organisasi target"})
+		}
+		if !seen[sid] {
+			seen[sid] = true
+			unique = append(unique, sid)
+		}
+	}
+	sourceIDs = unique
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	tx := h.db.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var target models.Organization
+	if err := tx.First(&target, "id = ?", id).Error; err != nil {
+		tx.Rollback()
+		if err == gorm.ErrRecordNotFound {
+			return fiber.NewError(http.StatusNotFound, "organization not found")
+		}
+		log.Error().Err(err).Str("id", idStr).Msg("failed to load merge target")
+		return fiber.NewError(http.StatusInternalServerError, "failed to merge organizations")
+	}
+
+	var sources []models.Organization
+	if err := tx.Where("id IN ?", sourceIDs).Order("created_at ASC").Find(&sources).Error; err != nil {
+		tx.Rollback()
+		log.Error().Err(err).Msg("failed to load merge sources")
+		return fiber.NewError(http.StatusInternalServerError, "failed to merge organizations")
+	}
+	if len(sources) != len(sourceIDs) {
+		tx.Rollback()
+
+		found := map[uuid.UUID]bool{}
+		for _, s := range sources {
+			found[s.ID] = true
+		}
+		missing := []string{}
+		for _, sid := range sourceIDs {
+			if !found[sid] {
+				missing = append(missing, sid.String())
+			}
+		}
+		return sendValidationError(c, map[string]string{"sourceIds": "organisasi tidak ditemukan: " + strings.Join(missing, ", ")})
+	}
+
+	expRes := tx.Model(&models.Experience{}).
+		Where("organization_id IN ?", sourceIDs).
+		Updates(map[string]any{"organization_id": target.ID, "company": target.Name})
+	if expRes.Error != nil {
+		tx.Rollback()
+		log.Error().Err(expRes.Error).Msg("failed to move experiences to merge target")
+		return fiber.NewError(http.StatusInternalServerError, "failed to merge organizations")
+	}
+
+	projRes := tx.Model(&models.Project{}).
+		Where("client_id IN ?", sourceIDs).
+		Update("client_id", target.ID)
+	if projRes.Error != nil {
+		tx.Rollback()
+		log.Error().Err(projRes.Error).Msg("failed to move projects to merge target")
+		return fiber.NewError(http.StatusInternalServerError, "failed to merge organizations")
+	}
+
+	// field kosong di target diisi dari source (yang paling lama dibuat duluan)
+	for _, s := range sources {
+		if target.LogoURL == "" {
+			target.LogoURL = s.LogoURL
+		}
+		if target.WebsiteURL == "" {
+			target.WebsiteURL = s.WebsiteURL
+		}
+		if target.Industry == "" {
+			target.Industry = s.Industry
+		}
+		if target.Location == "" {
+			target.Location = s.Location
+		}
+	}
+
+	if err := tx.Where("id IN ?", sourceIDs).Delete(&models.Organization{}).Error; err != nil {
+		tx.Rollback()
+		log.Error().Err(err).Msg("failed to delete merged organizations")
+		return fiber.NewError(http.StatusInternalServerError, "failed to merge organizations")
+	}
+
+	if err := tx.Save(&target).Error; err != nil {
+		tx.Rollback()
+		log.Error().Err(err).Msg("failed to update merge target")
+		return fiber.NewError(http.StatusInternalServerError, "failed to merge organizations")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		log.Error().Err(err).Msg("failed to commit organization merge")
+		return fiber.NewError(http.StatusInternalServerError, "failed to merge organizations")
+	}
+
+	changes.Notify(changes.Experiences, changes.Projects)
+
+	return c.JSON(OrganizationMergeResponse{
+		Data:               organizationToResponse(target),
+		Merged:             len(sources),
+		ExperiencesUpdated: expRes.RowsAffected,
+		ProjectsUpdated:    projRes.RowsAffected,
+	})
+}
@@ -0,0 +1,465 @@
+package handlers
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/changes"
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/FauzanParanditha/portfolio-backend/internal/pagination"
+	"github.com/FauzanParanditha/portfolio-backend/internal/validation"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+type AdminPostHandler struct {
+	db *gorm.DB
+}
+
+func NewAdminPostHandler(db *gorm.DB) *AdminPostHandler {
+	return &AdminPostHandler{db: db}
+}
+
+// Helper: estimasi waktu baca (menit) dari body markdown, ~200 kata/menit
+func readingTime(body string) int {
+	words := len(strings.Fields(body))
+	minutes := int(math.Ceil(float64(words) / 200))
+	if minutes < 1 {
+		minutes = 1
+	}
+	return minutes
+}
+
+// Helper: published tanpa tanggal → sekarang; draft tetap simpan tanggal kalau diisi
+func resolvePublishedAt(status string, publishedAt *time.Time, current *time.Time) *time.Time {
+	if publishedAt != nil {
+		t := publishedAt.UTC()
+		return &t
+	}
+	if status == models.PostStatusPublished {
+		if current != nil {
+			return current
+		}
+		now := time.Now().UTC()
+		return &now
+	}
+	return current
+}
+
+// Helper: cek slug sudah dipakai post lain
+func (h *AdminPostHandler) slugTaken(ctx context.Context, slug string, exceptID *uuid.UUID) (bool, error) {
+	q := h.db.WithContext(ctx).Model(&models.Post{}).Where("slug = ?", slug)
+	if exceptID != nil {
+		q = q.Where("id <> ?", *exceptID)
+	}
+
+	var n int64
+	if err := q.Count(&n).Error; err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// GET /api/v1/admin/posts
+// Admin List Posts godoc
+// @Summary      List all posts (admin)
+// @Description  Admin-only list including drafts, with search, status filter & pagination
+// @Tags         admin-posts
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        q          query  string false "Search keyword"
+// @Param        status     query  string false "draft | published"
+// @Param        page       query  int    false "Page"
+// @Param        limit      query  int    false "Limit"
+// @Param        cursor     query  string false "Opaque cursor from meta.nextCursor / meta.prevCursor"
+// @Param        withTotal  query  bool   false "Include total count (default false)"
+// @Success      200  {object}  PostsListResponse
+// @Failure      401  {object}  ErrorResponse
+// @Router       /admin/posts [get]
+func (h *AdminPostHandler) List(c *fiber.Ctx) error {
+	searchQ := c.Query("q")
+	status := c.Query("status")
+
+	page, err := strconv.Atoi(c.Query("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	limit, err := strconv.Atoi(c.Query("limit", "12"))
+	if err != nil || limit <= 0 {
+		limit = 12
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	list := cursorList(c, "admin-posts")
+	cursor, err := parseCursorQuery(c, list)
+	if err != nil {
+		return err
+	}
+	withTotal := withTotalQuery(c)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	q := h.db.WithContext(ctx).Model(&models.Post{})
+
+	if searchQ != "" {
+		like := "%" + searchQ + "%"
+		q = q.Where(
+			h.db.Where("posts.title ILIKE ?", like).
+				Or("posts.excerpt ILIKE ?", like).
+				Or("posts.body ILIKE ?", like),
+		)
+	}
+
+	if status != "" {
+		q = q.Where("posts.status = ?", status)
+	}
+
+	var total *int64
+	if withTotal {
+		var n int64
+		if err := q.Count(&n).Error; err != nil {
+			log.Error().Err(err).Msg("failed to count posts (admin)")
+			return fiber.NewError(http.StatusInternalServerError, "failed to fetch posts")
+		}
+		total = &n
+	}
+
+	pp := pagination.Params{Page: page, Limit: limit, Cursor: cursor}
+
+	var posts []models.Post
+	if err := pagination.Apply(q.Preload("Tags"), "posts", false, pp).
+		Find(&posts).Error; err != nil {
+
+		log.Error().Err(err).Msg("failed to list posts (admin)")
+		return fiber.NewError(http.StatusInternalServerError, "failed to fetch posts")
+	}
+
+	posts, info := pagination.Trim(posts, pp)
+
+	resp := make([]PostResponse, 0, len(posts))
+	for _, p := range posts {
+		resp = append(resp, postToResponse(p, false))
+	}
+
+	next, prev := cursorLinks(list, posts, info, adminPostCursor)
+
+	return c.JSON(fiber.Map{
+		"data": resp,
+		"meta": PaginationMeta{
+			Page:       page,
+			Limit:      limit,
+			Total:      total,
+			HasMore:    info.HasNext,
+			NextCursor: next,
+			PrevCursor: prev,
+			Query:      searchQ,
+		},
+	})
+}
+
+// GET /api/v1/admin/posts/:id
+// Admin Get Post godoc
+// @Summary      Get post by ID
+// @Tags         admin-posts
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id   path  string  true  "Post ID"
+// @Success      200  {object}  PostResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /admin/posts/{id} [get]
+func (h *AdminPostHandler) GetByID(c *fiber.Ctx) error {
+	idStr := c.Params("id")
+
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid post ID")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var post models.Post
+	if err := h.db.WithContext(ctx).
+		Preload("Tags").
+		First(&post, "id = ?", id).Error; err != nil {
+
+		if err == gorm.ErrRecordNotFound {
+			return fiber.NewError(http.StatusNotFound, "post not found")
+		}
+
+		log.Error().Err(err).Str("id", idStr).Msg("failed to get post (admin)")
+		return fiber.NewError(http.StatusInternalServerError, "failed to fetch post")
+	}
+
+	return c.JSON(fiber.Map{
+		"data": postToResponse(post, true),
+	})
+}
+
+// POST /api/v1/admin/posts
+// Admin Create Post godoc
+// @Summary      Create new post
+// @Tags         admin-posts
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        payload  body  PostCreateRequest  true  "Post payload"
+// @Success      201      {object}  PostResponse
+// @Failure      400      {object}  ErrorResponse
+// @Failure      409      {object}  ErrorResponse
+// @Failure      422      {object}  ErrorResponse
+// @Router       /admin/posts [post]
+func (h *AdminPostHandler) Create(c *fiber.Ctx) error {
+	var req PostCreateRequest
+
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid JSON body")
+	}
+
+	req.Seo = normalizeSEO(req.Seo)
+	if err := validation.ValidateStruct(&req); err != nil {
+		return sendValidationError(c, validation.ToFieldErrors(err))
+	}
+
+	tagUUIDs, err := parseUUIDs(req.TagIDs)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid tagIds")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	taken, err := h.slugTaken(ctx, req.Slug, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to check post slug")
+		return fiber.NewError(http.StatusInternalServerError, "failed to create post")
+	}
+	if taken {
+		return fiber.NewError(http.StatusConflict, "slug already used by another post")
+	}
+
+	post := models.Post{
+		Title:         req.Title,
+		Slug:          req.Slug,
+		Excerpt:       req.Excerpt,
+		Body:          req.Body,
+		CoverImageURL: req.CoverImageURL,
+
+		Status:      req.Status,
+		PublishedAt: resolvePublishedAt(req.Status, req.PublishedAt, nil),
+		ReadingTime: readingTime(req.Body),
+
+		SEO: seoFromRequest(req.Seo),
+	}
+
+	// post & relasi tag dibuat dalam satu transaksi: gagal di tengah = tidak ada post setengah jadi
+	tx := h.db.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var tags []models.Tag
+	if len(tagUUIDs) > 0 {
+		if err := tx.Where("id IN ?", tagUUIDs).Find(&tags).Error; err != nil {
+			tx.Rollback()
+			log.Error().Err(err).Msg("failed to load tags for post create")
+			return fiber.NewError(http.StatusInternalServerError, "failed to load tags")
+		}
+	}
+
+	if err := tx.Omit("Tags").Create(&post).Error; err != nil {
+		tx.Rollback()
+		// slug sudah dicek di atas, tapi create bersamaan bisa lolos pre-check
+		if isUniqueViolation(err) {
+			return fiber.NewError(http.StatusConflict, "slug already used by another post")
+		}
+		log.Error().Err(err).Msg("failed to create post")
+		return fiber.NewError(http.StatusInternalServerError, "failed to create post")
+	}
+
+	if len(tags) > 0 {
+		if err := tx.Model(&post).Association("Tags").Append(tags); err != nil {
+			tx.Rollback()
+			log.Error().Err(err).Msg("failed to create post tags")
+			return fiber.NewError(http.StatusInternalServerError, "failed to create post")
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		log.Error().Err(err).Msg("failed to commit post create")
+		return fiber.NewError(http.StatusInternalServerError, "failed to create post")
+	}
+
+	changes.Notify(changes.Posts)
+
+	return c.Status(http.StatusCreated).JSON(fiber.Map{
+		"data": postToResponse(post, true),
+	})
+}
+
+// PUT /api/v1/admin/posts/:id
+// Admin Update Post godoc
+// @Summary      Update post
+// @Tags         admin-posts
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id       path  string             true  "Post ID"
+// @Param        payload  body  PostUpdateRequest  true  "Post payload"
+// @Success      200      {object}  PostResponse
+// @Failure      400      {object}  ErrorResponse
+// @Failure      404      {object}  ErrorResponse
+// @Failure      409      {object}  ErrorResponse
+// @Failure      422      {object}  ErrorResponse
+// @Router       /admin/posts/{id} [put]
+func (h *AdminPostHandler) Update(c *fiber.Ctx) error {
+	idStr := c.Params("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid post ID")
+	}
+
+	var req PostUpdateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid JSON body")
+	}
+
+	req.Seo = normalizeSEO(req.Seo)
+	if err := validation.ValidateStruct(&req); err != nil {
+		return sendValidationError(c, validation.ToFieldErrors(err))
+	}
+
+	tagUUIDs, err := parseUUIDs(req.TagIDs)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid tagIds")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	taken, err := h.slugTaken(ctx, req.Slug, &id)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to check post slug")
+		return fiber.NewError(http.StatusInternalServerError, "failed to update post")
+	}
+	if taken {
+		return fiber.NewError(http.StatusConflict, "slug already used by another post")
+	}
+
+	tx := h.db.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var post models.Post
+	if err := tx.First(&post, "id = ?", id).Error; err != nil {
+		tx.Rollback()
+		if err == gorm.ErrRecordNotFound {
+			return fiber.NewError(http.StatusNotFound, "post not found")
+		}
+		log.Error().Err(err).Str("id", idStr).Msg("failed to load post for update")
+		return fiber.NewError(http.StatusInternalServerError, "failed to update post")
+	}
+
+	post.Title = req.Title
+	post.Slug = req.Slug
+	post.Excerpt = req.Excerpt
+	post.Body = req.Body
+	post.CoverImageURL = req.CoverImageURL
+	post.Status = req.Status
+	post.PublishedAt = resolvePublishedAt(req.Status, req.PublishedAt, post.PublishedAt)
+	post.ReadingTime = readingTime(req.Body)
+	post.SEO = seoFromRequest(req.Seo)
+
+	if err := tx.Omit("Tags").Save(&post).Error; err != nil {
+		tx.Rollback()
+		if isUniqueViolation(err) {
+			return fiber.NewError(http.StatusConflict, "slug already used by another post")
+		}
+		log.Error().Err(err).Msg("failed to update post")
+		return fiber.NewError(http.StatusInternalServerError, "failed to update post")
+	}
+
+	var tags []models.Tag
+	if len(tagUUIDs) > 0 {
+		if err := tx.Where("id IN ?", tagUUIDs).Find(&tags).Error; err != nil {
+			tx.Rollback()
+			log.Error().Err(err).Msg("failed to load tags for post update")
+			return fiber.NewError(http.StatusInternalServerError, "failed to update tags")
+		}
+	}
+	if err := tx.Model(&post).Association("Tags").Replace(tags); err != nil {
+		tx.Rollback()
+		log.Error().Err(err).Msg("failed to update post tags")
+		return fiber.NewError(http.StatusInternalServerError, "failed to update tags")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		log.Error().Err(err).Msg("failed to commit post update")
+		return fiber.NewError(http.StatusInternalServerError, "failed to update post")
+	}
+
+	changes.Notify(changes.Posts)
+
+	// reload
+	if err := h.db.WithContext(ctx).
+		Preload("Tags").
+		First(&post, "id = ?", post.ID).Error; err != nil {
+
+		log.Error().Err(err).Msg("failed to reload updated post")
+	}
+
+	return c.JSON(fiber.Map{
+		"data": postToResponse(post, true),
+	})
+}
+
+// DELETE /api/v1/admin/posts/:id
+// Admin Delete Post godoc
+// @Summary      Delete post
+// @Tags         admin-posts
+// @Security     BearerAuth
+// @Param        id   path  string  true "Post ID"
+// @Success      204  "No Content"
+// @Failure      404  {object}  ErrorResponse
+// @Router       /admin/posts/{id} [delete]
+func (h *AdminPostHandler) Delete(c *fiber.Ctx) error {
+	idStr := c.Params("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid post ID")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	res := h.db.WithContext(ctx).
+		Where("id = ?", id).
+		Delete(&models.Post{})
+	if res.Error != nil {
+		log.Error().Err(res.Error).Str("id", idStr).Msg("failed to delete post")
+		return fiber.NewError(http.StatusInternalServerError, "failed to delete post")
+	}
+	if res.RowsAffected == 0 {
+		return fiber.NewError(http.StatusNotFound, "post not found")
+	}
+
+	changes.Notify(changes.Posts)
+
+	return c.SendStatus(http.StatusNoContent)
+}
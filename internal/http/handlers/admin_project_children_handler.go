@@ -0,0 +1,199 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/FauzanParanditha/portfolio-backend/internal/validation"
+	"github.com/gofiber/fiber/v2"
+)
+
+// Sub-resource features & screenshots. ID anak tetap sama saat diedit / diurutkan ulang.
+
+func featureResp(f models.ProjectFeature) any       { return featureToResponse(f) }
+func screenshotResp(s models.ProjectScreenshot) any { return screenshotToResponse(s) }
+
+// POST /api/v1/admin/projects/:id/features
+// Admin Create Project Feature godoc
+// @Summary      Add a feature to a project
+// @Description  Appended after the existing features
+// @Tags         admin-projects
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id       path  string                 true  "Project ID"
+// @Param        payload  body  ProjectFeatureRequest  true  "Feature payload"
+// @Success      201  {object}  ProjectFeatureResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      422  {object}  ErrorResponse
+// @Router       /admin/projects/{id}/features [post]
+func (h *AdminProjectHandler) CreateFeature(c *fiber.Ctx) error {
+	var req ProjectFeatureRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid JSON body")
+	}
+
+	req.Text = strings.TrimSpace(req.Text)
+	if err := validation.ValidateStruct(&req); err != nil {
+		return sendValidationError(c, validation.ToFieldErrors(err))
+	}
+
+	return createChild(c, h.db, featureRoute, featureAccess, req.Text, featureResp)
+}
+
+// PUT /api/v1/admin/projects/:id/features/:childId
+// Admin Update Project Feature godoc
+// @Summary      Edit one project feature
+// @Tags         admin-projects
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id       path  string                 true  "Project ID"
+// @Param        childId  path  string                 true  "Feature ID"
+// @Param        payload  body  ProjectFeatureRequest  true  "Feature payload"
+// @Success      200  {object}  ProjectFeatureResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      422  {object}  ErrorResponse
+// @Router       /admin/projects/{id}/features/{childId} [put]
+func (h *AdminProjectHandler) UpdateFeature(c *fiber.Ctx) error {
+	var req ProjectFeatureRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid JSON body")
+	}
+
+	req.Text = strings.TrimSpace(req.Text)
+	if err := validation.ValidateStruct(&req); err != nil {
+		return sendValidationError(c, validation.ToFieldErrors(err))
+	}
+
+	return updateChild(c, h.db, featureRoute, featureAccess, req.Text, featureResp)
+}
+
+// DELETE /api/v1/admin/projects/:id/features/:childId
+// Admin Delete Project Feature godoc
+// @Summary      Delete one project feature
+// @Tags         admin-projects
+// @Security     BearerAuth
+// @Param        id       path  string  true  "Project ID"
+// @Param        childId  path  string  true  "Feature ID"
+// @Success      204  "No Content"
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /admin/projects/{id}/features/{childId} [delete]
+func (h *AdminProjectHandler) DeleteFeature(c *fiber.Ctx) error {
+	return deleteChild[models.ProjectFeature](c, h.db, featureRoute)
+}
+
+// PATCH /api/v1/admin/projects/:id/features/order
+// Admin Reorder Project Features godoc
+// @Summary      Reorder project features
+// @Description  ids must list every feature of the project exactly once, in the new order
+// @Tags         admin-projects
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id       path  string          true  "Project ID"
+// @Param        payload  body  ReorderRequest  true  "Feature IDs in display order"
+// @Success      200  {array}   ProjectFeatureResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      422  {object}  ErrorResponse
+// @Router       /admin/projects/{id}/features/order [patch]
+func (h *AdminProjectHandler) ReorderFeatures(c *fiber.Ctx) error {
+	return reorderChildren(c, h.db, featureRoute, featureResp)
+}
+
+// POST /api/v1/admin/projects/:id/screenshots
+// Admin Create Project Screenshot godoc
+// @Summary      Add a screenshot to a project
+// @Description  Appended after the existing screenshots
+// @Tags         admin-projects
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id       path  string                    true  "Project ID"
+// @Param        payload  body  ProjectScreenshotRequest  true  "Screenshot payload"
+// @Success      201  {object}  ProjectScreenshotResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      422  {object}  ErrorResponse
+// @Router       /admin/projects/{id}/screenshots [post]
+func (h *AdminProjectHandler) CreateScreenshot(c *fiber.Ctx) error {
+	var req ProjectScreenshotRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid JSON body")
+	}
+
+	req.ImageURL = strings.TrimSpace(req.ImageURL)
+	if err := validation.ValidateStruct(&req); err != nil {
+		return sendValidationError(c, validation.ToFieldErrors(err))
+	}
+
+	return createChild(c, h.db, screenshotRoute, screenshotAccess, req.ImageURL, screenshotResp)
+}
+
+// PUT /api/v1/admin/projects/:id/screenshots/:childId
+// Admin Update Project Screenshot godoc
+// @Summary      Replace one project screenshot
+// @Tags         admin-projects
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id       path  string                    true  "Project ID"
+// @Param        childId  path  string                    true  "Screenshot ID"
+// @Param        payload  body  ProjectScreenshotRequest  true  "Screenshot payload"
+// @Success      200  {object}  ProjectScreenshotResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      422  {object}  ErrorResponse
+// @Router       /admin/projects/{id}/screenshots/{childId} [put]
+func (h *AdminProjectHandler) UpdateScreenshot(c *fiber.Ctx) error {
+	var req ProjectScreenshotRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid JSON body")
+	}
+
+	req.ImageURL = strings.TrimSpace(req.ImageURL)
+	if err := validation.ValidateStruct(&req); err != nil {
+		return sendValidationError(c, validation.ToFieldErrors(err))
+	}
+
+	return updateChild(c, h.db, screenshotRoute, screenshotAccess, req.ImageURL, screenshotResp)
+}
+
+// DELETE /api/v1/admin/projects/:id/screenshots/:childId
+// Admin Delete Project Screenshot godoc
+// @Summary      Delete one project screenshot
+// @Tags         admin-projects
+// @Security     BearerAuth
+// @Param        id       path  string  true  "Project ID"
+// @Param        childId  path  string  true  "Screenshot ID"
+// @Success      204  "No Content"
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /admin/projects/{id}/screenshots/{childId} [delete]
+func (h *AdminProjectHandler) DeleteScreenshot(c *fiber.Ctx) error {
+	return deleteChild[models.ProjectScreenshot](c, h.db, screenshotRoute)
+}
+
+// PATCH /api/v1/admin/projects/:id/screenshots/order
+// Admin Reorder Project Screenshots godoc
+// @Summary      Reorder project screenshots
+// @Description  ids must list every screenshot of the project exactly once, in the new order
+// @Tags         admin-projects
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id       path  string          true  "Project ID"
+// @Param        payload  body  ReorderRequest  true  "Screenshot IDs in display order"
+// @Success      200  {array}   ProjectScreenshotResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      422  {object}  ErrorResponse
+// @Router       /admin/projects/{id}/screenshots/order [patch]
+func (h *AdminProjectHandler) ReorderScreenshots(c *fiber.Ctx) error {
+	return reorderChildren(c, h.db, screenshotRoute, screenshotResp)
+}
@@ -3,37 +3,48 @@ package handlers
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"strconv"
 	"time"
 
+	"github.com/FauzanParanditha/portfolio-backend/internal/changes"
 	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/FauzanParanditha/portfolio-backend/internal/pagination"
+	"github.com/FauzanParanditha/portfolio-backend/internal/repository"
 	"github.com/FauzanParanditha/portfolio-backend/internal/validation"
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type ProjectUpdateRequest = ProjectCreateRequest
 
 // Response meta untuk list admin
 type PaginationMeta struct {
-	Page     int    `json:"page"`
-	Limit    int    `json:"limit"`
-	Total    int64  `json:"total"`
-	HasMore  bool   `json:"hasMore"`
-	Query    string `json:"q,omitempty"`
-	Featured bool   `json:"featured"`
+	Page       int     `json:"page"`
+	Limit      int     `json:"limit"`
+	Total      *int64  `json:"total,omitempty"` // hanya kalau ?withTotal=true
+	HasMore    bool    `json:"hasMore"`
+	NextCursor *string `json:"nextCursor"`
+	PrevCursor *string `json:"prevCursor"`
+	Query      string  `json:"q,omitempty"`
+	Featured   bool    `json:"featured"`
 }
 
 type AdminProjectHandler struct {
-	db *gorm.DB
+	db      *gorm.DB
+	schemas repository.ProjectSchemaRepository
 }
 
 func NewAdminProjectHandler(db *gorm.DB) *AdminProjectHandler {
-	return &AdminProjectHandler{db: db}
+	return &AdminProjectHandler{
+		db:      db,
+		schemas: repository.NewProjectSchemaRepository(db),
+	}
 }
 
 // Helper: kirim response error validasi
@@ -47,8 +58,8 @@ func sendValidationError(c *fiber.Ctx, fieldErrors map[string]string) error {
 	})
 }
 
-// Helper: parse tag IDs string → []uuid.UUID
-func parseTagIDs(ids []string) ([]uuid.UUID, error) {
+// Helper: parse list UUID string (tagIds, experienceIds, ...) → []uuid.UUID
+func parseUUIDs(ids []string) ([]uuid.UUID, error) {
 	result := make([]uuid.UUID, 0, len(ids))
 	for _, s := range ids {
 		if s == "" {
@@ -71,11 +82,16 @@ func parseTagIDs(ids []string) ([]uuid.UUID, error) {
 // @Security     BearerAuth
 // @Accept       json
 // @Produce      json
-// @Param        q         query  string false "Search keyword"
-// @Param        featured  query  bool   false "Filter featured"
-// @Param        page      query  int    false "Page"
-// @Param        limit     query  int    false "Limit"
+// @Param        q          query  string false "Search keyword"
+// @Param        featured   query  bool   false "Filter featured"
+// @Param        page       query  int    false "Page"
+// @Param        limit      query  int    false "Limit"
+// @Param        cursor     query  string false "Opaque cursor from meta.nextCursor / meta.prevCursor"
+// @Param        withTotal  query  bool   false "Include total count (default false)"
+// @Param        fields     query  string false "Comma-separated response fields"
+// @Param        include    query  string false "Relations to load: tags,features,screenshots,experiences,client"
 // @Success      200  {object}  ProjectsListResponse
+// @Failure      400  {object}  ErrorResponse
 // @Failure      401  {object}  ErrorResponse
 // @Router       /admin/projects [get]
 func (h *AdminProjectHandler) List(c *fiber.Ctx) error {
@@ -93,20 +109,23 @@ func (h *AdminProjectHandler) List(c *fiber.Ctx) error {
 	if limit > 100 {
 		limit = 100
 	}
-	offset := (page - 1) * limit
+
+	list := cursorList(c, "admin-projects")
+	cursor, err := parseCursorQuery(c, list)
+	if err != nil {
+		return err
+	}
+	withTotal := withTotalQuery(c)
+
+	sparse, err := parseSparseQuery(c, projectFieldSpec)
+	if err != nil {
+		return err
+	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	q := h.db.WithContext(ctx).
-		Preload("Features", func(db *gorm.DB) *gorm.DB {
-			return db.Order("project_features.sort_order ASC")
-		}).
-		Preload("Tags").
-		Preload("Screenshots", func(db *gorm.DB) *gorm.DB {
-			return db.Order("project_screenshots.sort_order ASC")
-		}).
-		Model(&models.Project{})
+	q := h.db.WithContext(ctx).Model(&models.Project{})
 
 	if searchQ != "" {
 		like := "%" + searchQ + "%"
@@ -122,40 +141,49 @@ func (h *AdminProjectHandler) List(c *fiber.Ctx) error {
 		q = q.Where("projects.is_featured = ?", true)
 	}
 
-	var total int64
-	if err := q.Count(&total).Error; err != nil {
-		log.Error().Err(err).Msg("failed to count projects (admin)")
-		return fiber.NewError(http.StatusInternalServerError, "failed to fetch projects")
+	var total *int64
+	if withTotal {
+		var n int64
+		if err := q.Count(&n).Error; err != nil {
+			log.Error().Err(err).Msg("failed to count projects (admin)")
+			return fiber.NewError(http.StatusInternalServerError, "failed to fetch projects")
+		}
+		total = &n
 	}
 
+	// select + preload dipasang setelah count
+	q = repository.ProjectQuery(q, sparse.proj)
+
+	pp := pagination.Params{Page: page, Limit: limit, Cursor: cursor}
+
 	var projects []models.Project
-	if err := q.
-		Order("projects.sort_order ASC").
-		Order("projects.created_at DESC").
-		Limit(limit).
-		Offset(offset).
+	if err := pagination.Apply(q, "projects", true, pp).
 		Find(&projects).Error; err != nil {
 
 		log.Error().Err(err).Msg("failed to list projects (admin)")
 		return fiber.NewError(http.StatusInternalServerError, "failed to fetch projects")
 	}
 
+	projects, info := pagination.Trim(projects, pp)
+
 	resp := make([]ProjectResponse, 0, len(projects))
 	for _, p := range projects {
 		resp = append(resp, projectToResponse(p))
 	}
 
-	hasMore := int64(page*limit) < total
+	next, prev := cursorLinks(list, projects, info, projectCursor)
 
 	return c.JSON(fiber.Map{
-		"data": resp,
+		"data": sparseList(sparse, resp, projectFieldSpec),
 		"meta": PaginationMeta{
-			Page:     page,
-			Limit:    limit,
-			Total:    total,
-			HasMore:  hasMore,
-			Query:    searchQ,
-			Featured: featured,
+			Page:       page,
+			Limit:      limit,
+			Total:      total,
+			HasMore:    info.HasNext,
+			NextCursor: next,
+			PrevCursor: prev,
+			Query:      searchQ,
+			Featured:   featured,
 		},
 	})
 }
@@ -167,8 +195,11 @@ func (h *AdminProjectHandler) List(c *fiber.Ctx) error {
 // @Security     BearerAuth
 // @Accept       json
 // @Produce      json
-// @Param        id   path  string  true  "Project ID"
+// @Param        id       path   string  true   "Project ID"
+// @Param        fields   query  string  false  "Comma-separated response fields"
+// @Param        include  query  string  false  "Relations to load: tags,features,screenshots,experiences,client"
 // @Success      200  {object}  ProjectResponse
+// @Failure      400  {object}  ErrorResponse
 // @Failure      404  {object}  ErrorResponse
 // @Router       /admin/projects/{id} [get]
 func (h *AdminProjectHandler) GetByID(c *fiber.Ctx) error {
@@ -179,19 +210,17 @@ func (h *AdminProjectHandler) GetByID(c *fiber.Ctx) error {
 		return fiber.NewError(http.StatusBadRequest, "invalid project ID")
 	}
 
+	sparse, err := parseSparseQuery(c, projectFieldSpec)
+	if err != nil {
+		return err
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
 	var project models.Project
-	if err := h.db.WithContext(ctx).
-		Preload("Features", func(db *gorm.DB) *gorm.DB {
-			return db.Order("project_features.sort_order ASC")
-		}).
-		Preload("Tags").
-		Preload("Screenshots", func(db *gorm.DB) *gorm.DB {
-			return db.Order("project_screenshots.sort_order ASC")
-		}).
-		First(&project, "id = ?", id).Error; err != nil {
+	if err := repository.ProjectQuery(h.db.WithContext(ctx), sparse.proj).
+		First(&project, "projects.id = ?", id).Error; err != nil {
 
 		if err == gorm.ErrRecordNotFound {
 			return fiber.NewError(http.StatusNotFound, "project not found")
@@ -202,7 +231,7 @@ func (h *AdminProjectHandler) GetByID(c *fiber.Ctx) error {
 	}
 
 	return c.JSON(fiber.Map{
-		"data": projectToResponse(project),
+		"data": sparse.apply(projectToResponse(project), projectFieldSpec),
 	})
 }
 
@@ -225,6 +254,8 @@ func (h *AdminProjectHandler) Create(c *fiber.Ctx) error {
 		return fiber.NewError(http.StatusBadRequest, "invalid JSON body")
 	}
 
+	req.Seo = normalizeSEO(req.Seo)
+	req.ClientID = nilOrTrimmed(req.ClientID)
 	if err := validation.ValidateStruct(&req); err != nil {
 		fieldErrors := validation.ToFieldErrors(err)
 		return sendValidationError(c, fieldErrors)
@@ -233,19 +264,33 @@ func (h *AdminProjectHandler) Create(c *fiber.Ctx) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	tagUUIDs, err := parseTagIDs(req.TagIDs)
+	tagUUIDs, err := parseUUIDs(req.TagIDs)
 	if err != nil {
 		return fiber.NewError(http.StatusBadRequest, "invalid tagIds")
 	}
 
-	// marshal technicalDetails (map -> JSON)
-	var technicalDetails datatypes.JSON
-	if req.TechnicalDetails != nil {
-		if b, err := json.Marshal(req.TechnicalDetails); err == nil {
-			technicalDetails = datatypes.JSON(b)
-		} else {
-			log.Warn().Err(err).Msg("failed to marshal technicalDetails, ignoring")
+	experienceUUIDs, err := parseUUIDs(req.ExperienceIDs)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid experienceIds")
+	}
+
+	client, err := findOrganization(h.db.WithContext(ctx), req.ClientID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return sendValidationError(c, map[string]string{"clientId": "organisasi tidak ditemukan"})
 		}
+		log.Error().Err(err).Msg("failed to load client organization for project")
+		return fiber.NewError(http.StatusInternalServerError, "failed to load organization")
+	}
+
+	// marshal + validasi technicalDetails terhadap schema kategori
+	technicalDetails, fieldErrors, err := prepareTechnicalDetails(ctx, h.schemas, req.Category, req.TechnicalDetails)
+	if err != nil {
+		log.Error().Err(err).Str("category", req.Category).Msg("failed to validate technicalDetails")
+		return fiber.NewError(http.StatusInternalServerError, "failed to validate technicalDetails")
+	}
+	if len(fieldErrors) > 0 {
+		return sendValidationError(c, fieldErrors)
 	}
 
 	tx := h.db.WithContext(ctx).Begin()
@@ -276,8 +321,12 @@ func (h *AdminProjectHandler) Create(c *fiber.Ctx) error {
 		DemoURL: req.DemoURL,
 		RepoURL: req.RepoURL,
 
+		SEO: seoFromRequest(req.Seo),
+
 		IsFeatured: req.IsFeatured,
 		SortOrder:  req.SortOrder,
+
+		ClientID: organizationID(client),
 	}
 
 	// Handle tags (many-to-many)
@@ -291,6 +340,17 @@ func (h *AdminProjectHandler) Create(c *fiber.Ctx) error {
 		project.Tags = tags
 	}
 
+	// Experiences (many-to-many experience_projects)
+	if len(experienceUUIDs) > 0 {
+		var exps []models.Experience
+		if err := tx.Where("id IN ?", experienceUUIDs).Find(&exps).Error; err != nil {
+			tx.Rollback()
+			log.Error().Err(err).Msg("failed to load experiences for project create")
+			return fiber.NewError(http.StatusInternalServerError, "failed to load experiences")
+		}
+		project.Experiences = exps
+	}
+
 	if err := tx.Create(&project).Error; err != nil {
 		tx.Rollback()
 		log.Error().Err(err).Msg("failed to create project")
@@ -346,16 +406,11 @@ func (h *AdminProjectHandler) Create(c *fiber.Ctx) error {
 		return fiber.NewError(http.StatusInternalServerError, "failed to create project")
 	}
 
+	changes.Notify(changes.Projects, changes.Experiences)
+
 	// reload with relations
-	if err := h.db.WithContext(ctx).
-		Preload("Features", func(db *gorm.DB) *gorm.DB {
-			return db.Order("project_features.sort_order ASC")
-		}).
-		Preload("Tags").
-		Preload("Screenshots", func(db *gorm.DB) *gorm.DB {
-			return db.Order("project_screenshots.sort_order ASC")
-		}).
-		First(&project, "id = ?", project.ID).Error; err != nil {
+	if err := repository.ProjectQuery(h.db.WithContext(ctx), repository.Projection{}).
+		First(&project, "projects.id = ?", project.ID).Error; err != nil {
 
 		log.Error().Err(err).Msg("failed to reload created project")
 	}
@@ -389,6 +444,8 @@ func (h *AdminProjectHandler) Update(c *fiber.Ctx) error {
 		return fiber.NewError(http.StatusBadRequest, "invalid JSON body")
 	}
 
+	req.Seo = normalizeSEO(req.Seo)
+	req.ClientID = nilOrTrimmed(req.ClientID)
 	if err := validation.ValidateStruct(&req); err != nil {
 		fieldErrors := validation.ToFieldErrors(err)
 		return sendValidationError(c, fieldErrors)
@@ -397,19 +454,23 @@ func (h *AdminProjectHandler) Update(c *fiber.Ctx) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	tagUUIDs, err := parseTagIDs(req.TagIDs)
+	tagUUIDs, err := parseUUIDs(req.TagIDs)
 	if err != nil {
 		return fiber.NewError(http.StatusBadRequest, "invalid tagIds")
 	}
 
-	// marshal technicalDetails (map -> JSON)
-	var technicalDetails datatypes.JSON
-	if req.TechnicalDetails != nil {
-		if b, err := json.Marshal(req.TechnicalDetails); err == nil {
-			technicalDetails = datatypes.JSON(b)
-		} else {
-			log.Warn().Err(err).Msg("failed to marshal technicalDetails, ignoring")
+	experienceUUIDs, err := parseUUIDs(req.ExperienceIDs)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid experienceIds")
+	}
+
+	client, err := findOrganization(h.db.WithContext(ctx), req.ClientID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return sendValidationError(c, map[string]string{"clientId": "organisasi tidak ditemukan"})
 		}
+		log.Error().Err(err).Msg("failed to load client organization for project")
+		return fiber.NewError(http.StatusInternalServerError, "failed to load organization")
 	}
 
 	tx := h.db.WithContext(ctx).Begin()
@@ -430,6 +491,29 @@ func (h *AdminProjectHandler) Update(c *fiber.Ctx) error {
 		return fiber.NewError(http.StatusInternalServerError, "failed to update project")
 	}
 
+	// technicalDetails tidak dikirim → yang tersimpan dipertahankan, jadi itu yang
+	// divalidasi terhadap schema kategori (baru)
+	details := req.TechnicalDetails
+	if details == nil && len(project.TechnicalDetails) > 0 {
+		if err := json.Unmarshal(project.TechnicalDetails, &details); err != nil {
+			tx.Rollback()
+			log.Error().Err(err).Str("id", idStr).Msg("failed to decode stored technicalDetails")
+			return fiber.NewError(http.StatusInternalServerError, "failed to validate technicalDetails")
+		}
+	}
+
+	// marshal + validasi technicalDetails terhadap schema kategori
+	technicalDetails, fieldErrors, err := prepareTechnicalDetails(ctx, h.schemas, req.Category, details)
+	if err != nil {
+		tx.Rollback()
+		log.Error().Err(err).Str("category", req.Category).Msg("failed to validate technicalDetails")
+		return fiber.NewError(http.StatusInternalServerError, "failed to validate technicalDetails")
+	}
+	if len(fieldErrors) > 0 {
+		tx.Rollback()
+		return sendValidationError(c, fieldErrors)
+	}
+
 	// Update scalar fields
 	project.Title = req.Title
 	project.Slug = req.Slug
@@ -452,8 +536,10 @@ func (h *AdminProjectHandler) Update(c *fiber.Ctx) error {
 
 	project.DemoURL = req.DemoURL
 	project.RepoURL = req.RepoURL
+	project.SEO = seoFromRequest(req.Seo)
 	project.IsFeatured = req.IsFeatured
 	project.SortOrder = req.SortOrder
+	project.ClientID = organizationID(client)
 
 	if err := tx.Save(&project).Error; err != nil {
 		tx.Rollback()
@@ -483,60 +569,44 @@ func (h *AdminProjectHandler) Update(c *fiber.Ctx) error {
 		}
 	}
 
-	// Update features: hapus dulu, lalu insert baru
-	if err := tx.Where("project_id = ?", project.ID).Delete(&models.ProjectFeature{}).Error; err != nil {
+	// update experiences
+	var exps []models.Experience
+	if len(experienceUUIDs) > 0 {
+		if err := tx.Where("id IN ?", experienceUUIDs).Find(&exps).Error; err != nil {
+			tx.Rollback()
+			log.Error().Err(err).Msg("failed to load experiences for project update")
+			return fiber.NewError(http.StatusInternalServerError, "failed to update experiences")
+		}
+	}
+	if err := tx.Model(&project).Association("Experiences").Replace(exps); err != nil {
 		tx.Rollback()
-		log.Error().Err(err).Msg("failed to delete old project features")
-		return fiber.NewError(http.StatusInternalServerError, "failed to update features")
+		log.Error().Err(err).Msg("failed to update project experiences")
+		return fiber.NewError(http.StatusInternalServerError, "failed to update experiences")
 	}
 
-	if len(req.Features) > 0 {
-		features := make([]models.ProjectFeature, 0, len(req.Features))
-		for i, text := range req.Features {
-			if text == "" {
-				continue
-			}
-			features = append(features, models.ProjectFeature{
-				ProjectID: project.ID,
-				Text:      text,
-				SortOrder: i,
-			})
-		}
-		if len(features) > 0 {
-			if err := tx.Create(&features).Error; err != nil {
-				tx.Rollback()
-				log.Error().Err(err).Msg("failed to create new project features")
-				return fiber.NewError(http.StatusInternalServerError, "failed to update features")
-			}
-		}
+	// Update features & screenshots: baris lama dipakai ulang supaya ID tetap stabil
+	var oldFeatures []models.ProjectFeature
+	if err := tx.Where("project_id = ?", project.ID).Order("sort_order ASC").Find(&oldFeatures).Error; err != nil {
+		tx.Rollback()
+		log.Error().Err(err).Msg("failed to load project features for update")
+		return fiber.NewError(http.StatusInternalServerError, "failed to update features")
+	}
+	if err := syncOrdered(tx, featureAccess, project.ID, oldFeatures, req.Features); err != nil {
+		tx.Rollback()
+		log.Error().Err(err).Msg("failed to sync project features")
+		return fiber.NewError(http.StatusInternalServerError, "failed to update features")
 	}
 
-	// Update screenshots: hapus dulu, buat ulang
-	if err := tx.Where("project_id = ?", project.ID).Delete(&models.ProjectScreenshot{}).Error; err != nil {
+	var oldScreens []models.ProjectScreenshot
+	if err := tx.Where("project_id = ?", project.ID).Order("sort_order ASC").Find(&oldScreens).Error; err != nil {
 		tx.Rollback()
-		log.Error().Err(err).Msg("failed to delete old project screenshots")
+		log.Error().Err(err).Msg("failed to load project screenshots for update")
 		return fiber.NewError(http.StatusInternalServerError, "failed to update screenshots")
 	}
-
-	if len(req.Screenshots) > 0 {
-		screens := make([]models.ProjectScreenshot, 0, len(req.Screenshots))
-		for i, url := range req.Screenshots {
-			if url == "" {
-				continue
-			}
-			screens = append(screens, models.ProjectScreenshot{
-				ProjectID: project.ID,
-				ImageURL:  url,
-				SortOrder: i,
-			})
-		}
-		if len(screens) > 0 {
-			if err := tx.Create(&screens).Error; err != nil {
-				tx.Rollback()
-				log.Error().Err(err).Msg("failed to create new project screenshots")
-				return fiber.NewError(http.StatusInternalServerError, "failed to update screenshots")
-			}
-		}
+	if err := syncOrdered(tx, screenshotAccess, project.ID, oldScreens, req.Screenshots); err != nil {
+		tx.Rollback()
+		log.Error().Err(err).Msg("failed to sync project screenshots")
+		return fiber.NewError(http.StatusInternalServerError, "failed to update screenshots")
 	}
 
 	if err := tx.Commit().Error; err != nil {
@@ -544,25 +614,232 @@ func (h *AdminProjectHandler) Update(c *fiber.Ctx) error {
 		return fiber.NewError(http.StatusInternalServerError, "failed to update project")
 	}
 
+	changes.Notify(changes.Projects, changes.Experiences)
+
 	// reload
-	if err := h.db.WithContext(ctx).
+	if err := repository.ProjectQuery(h.db.WithContext(ctx), repository.Projection{}).
+		First(&project, "projects.id = ?", project.ID).Error; err != nil {
+
+		log.Error().Err(err).Msg("failed to reload updated project")
+	}
+
+	return c.JSON(fiber.Map{
+		"data": projectToResponse(project),
+	})
+}
+
+// POST /api/v1/admin/projects/:id/duplicate
+// Admin Duplicate Project godoc
+// @Summary      Duplicate project
+// @Description  Deep-copy project (features, screenshots, tags, experiences, client, results, technical details) with a new unique slug, not featured
+// @Tags         admin-projects
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id   path  string  true "Project ID"
+// @Success      201  {object}  ProjectResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      409  {object}  ErrorResponse
+// @Router       /admin/projects/{id}/duplicate [post]
+func (h *AdminProjectHandler) Duplicate(c *fiber.Ctx) error {
+	idStr := c.Params("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid project ID")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	tx := h.db.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// FOR SHARE: PUT / delete / edit child (yang mengunci project FOR UPDATE) menunggu
+	// sampai copy selesai, jadi children yang di-preload di tx ini konsisten dengan parent
+	var src models.Project
+	if err := tx.
+		Clauses(clause.Locking{Strength: "SHARE"}).
 		Preload("Features", func(db *gorm.DB) *gorm.DB {
 			return db.Order("project_features.sort_order ASC")
 		}).
 		Preload("Tags").
+		Preload("Experiences").
 		Preload("Screenshots", func(db *gorm.DB) *gorm.DB {
 			return db.Order("project_screenshots.sort_order ASC")
 		}).
-		First(&project, "id = ?", project.ID).Error; err != nil {
+		First(&src, "id = ?", id).Error; err != nil {
 
-		log.Error().Err(err).Msg("failed to reload updated project")
+		tx.Rollback()
+		if err == gorm.ErrRecordNotFound {
+			return fiber.NewError(http.StatusNotFound, "project not found")
+		}
+		log.Error().Err(err).Str("id", idStr).Msg("failed to load project for duplicate")
+		return fiber.NewError(http.StatusInternalServerError, "failed to duplicate project")
 	}
 
-	return c.JSON(fiber.Map{
+	slug, err := uniqueCopySlug(tx, src.Slug)
+	if err != nil {
+		tx.Rollback()
+		log.Error().Err(err).Str("slug", src.Slug).Msg("failed to derive slug for duplicate")
+		return fiber.NewError(http.StatusInternalServerError, "failed to duplicate project")
+	}
+
+	results := make([]string, len(src.Results))
+	copy(results, src.Results)
+
+	var technicalDetails datatypes.JSON
+	if src.TechnicalDetails != nil {
+		technicalDetails = make(datatypes.JSON, len(src.TechnicalDetails))
+		copy(technicalDetails, src.TechnicalDetails)
+	}
+
+	project := models.Project{
+		Title:         src.Title + " (Copy)",
+		Slug:          slug,
+		ShortDesc:     src.ShortDesc,
+		LongDesc:      src.LongDesc,
+		CoverImageURL: src.CoverImageURL,
+
+		Category: src.Category,
+		Timeline: src.Timeline,
+		Role:     src.Role,
+
+		Challenge: src.Challenge,
+		Solution:  src.Solution,
+
+		Results: results,
+
+		TechnicalDetails: technicalDetails,
+
+		DemoURL: src.DemoURL,
+		RepoURL: src.RepoURL,
+
+		// canonical tidak ikut dicopy, slug-nya beda
+		SEO: models.SEO{
+			MetaTitle:       src.SEO.MetaTitle,
+			MetaDescription: src.SEO.MetaDescription,
+			OGImageURL:      src.SEO.OGImageURL,
+		},
+
+		IsFeatured: false,
+		SortOrder:  src.SortOrder,
+
+		// tag & experience dipakai bersama, cukup relasinya yang dicopy
+		Tags:        src.Tags,
+		Experiences: src.Experiences,
+
+		ClientID: src.ClientID,
+	}
+
+	// slug dipilih read-then-insert, jadi duplicate bersamaan bisa dapat slug yang sama.
+	// Yang kalah (unique violation) balik ke savepoint lalu coba slug berikutnya.
+	for attempt := 1; ; attempt++ {
+		if err := tx.SavePoint("duplicate_slug").Error; err != nil {
+			tx.Rollback()
+			log.Error().Err(err).Msg("failed to create savepoint for duplicate")
+			return fiber.NewError(http.StatusInternalServerError, "failed to duplicate project")
+		}
+
+		err := tx.Create(&project).Error
+		if err == nil {
+			break
+		}
+		if !isUniqueViolation(err) {
+			tx.Rollback()
+			log.Error().Err(err).Msg("failed to create duplicated project")
+			return fiber.NewError(http.StatusInternalServerError, "failed to duplicate project")
+		}
+		if attempt == maxCopySlugAttempts {
+			tx.Rollback()
+			return fiber.NewError(http.StatusConflict, "could not find a free slug for the copy, try again")
+		}
+
+		if err := tx.RollbackTo("duplicate_slug").Error; err != nil {
+			tx.Rollback()
+			log.Error().Err(err).Msg("failed to roll back to savepoint for duplicate")
+			return fiber.NewError(http.StatusInternalServerError, "failed to duplicate project")
+		}
+
+		if project.Slug, err = uniqueCopySlug(tx, src.Slug); err != nil {
+			tx.Rollback()
+			log.Error().Err(err).Str("slug", src.Slug).Msg("failed to derive slug for duplicate")
+			return fiber.NewError(http.StatusInternalServerError, "failed to duplicate project")
+		}
+	}
+
+	if len(src.Features) > 0 {
+		features := make([]models.ProjectFeature, 0, len(src.Features))
+		for _, f := range src.Features {
+			features = append(features, models.ProjectFeature{
+				ProjectID: project.ID,
+				Text:      f.Text,
+				SortOrder: f.SortOrder,
+			})
+		}
+		if err := tx.Create(&features).Error; err != nil {
+			tx.Rollback()
+			log.Error().Err(err).Msg("failed to duplicate project features")
+			return fiber.NewError(http.StatusInternalServerError, "failed to duplicate project features")
+		}
+	}
+
+	if len(src.Screenshots) > 0 {
+		screens := make([]models.ProjectScreenshot, 0, len(src.Screenshots))
+		for _, s := range src.Screenshots {
+			screens = append(screens, models.ProjectScreenshot{
+				ProjectID: project.ID,
+				ImageURL:  s.ImageURL,
+				SortOrder: s.SortOrder,
+			})
+		}
+		if err := tx.Create(&screens).Error; err != nil {
+			tx.Rollback()
+			log.Error().Err(err).Msg("failed to duplicate project screenshots")
+			return fiber.NewError(http.StatusInternalServerError, "failed to duplicate project screenshots")
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		log.Error().Err(err).Msg("failed to commit project duplicate")
+		return fiber.NewError(http.StatusInternalServerError, "failed to duplicate project")
+	}
+
+	changes.Notify(changes.Projects, changes.Experiences)
+
+	// reload with relations
+	if err := repository.ProjectQuery(h.db.WithContext(ctx), repository.Projection{}).
+		First(&project, "projects.id = ?", project.ID).Error; err != nil {
+
+		log.Error().Err(err).Msg("failed to reload duplicated project")
+	}
+
+	return c.Status(http.StatusCreated).JSON(fiber.Map{
 		"data": projectToResponse(project),
 	})
 }
 
+// batas percobaan slug copy kalau kalah balapan dengan duplicate lain
+const maxCopySlugAttempts = 3
+
+// Helper: cari slug turunan yang belum dipakai, misal "foo-copy", "foo-copy-2", ...
+func uniqueCopySlug(tx *gorm.DB, base string) (string, error) {
+	candidate := base + "-copy"
+
+	for i := 2; ; i++ {
+		var count int64
+		if err := tx.Model(&models.Project{}).Where("slug = ?", candidate).Count(&count).Error; err != nil {
+			return "", err
+		}
+		if count == 0 {
+			return candidate, nil
+		}
+		candidate = fmt.Sprintf("%s-copy-%d", base, i)
+	}
+}
+
 // DELETE /api/v1/admin/projects/:id
 // Admin Delete Project godoc
 // @Summary      Delete project
@@ -590,5 +867,7 @@ func (h *AdminProjectHandler) Delete(c *fiber.Ctx) error {
 		return fiber.NewError(http.StatusInternalServerError, "failed to delete project")
 	}
 
+	changes.Notify(changes.Projects, changes.Experiences)
+
 	return c.SendStatus(http.StatusNoContent)
 }
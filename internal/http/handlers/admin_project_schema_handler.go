@@ -0,0 +1,204 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/FauzanParanditha/portfolio-backend/internal/repository"
+	"github.com/FauzanParanditha/portfolio-backend/internal/validation"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"gorm.io/datatypes"
+)
+
+type AdminProjectSchemaHandler struct {
+	repo repository.ProjectSchemaRepository
+}
+
+func NewAdminProjectSchemaHandler(repo repository.ProjectSchemaRepository) *AdminProjectSchemaHandler {
+	return &AdminProjectSchemaHandler{repo: repo}
+}
+
+// GET /api/v1/admin/project-schemas
+// Admin List Project Schemas godoc
+// @Summary      List technicalDetails JSON Schemas per category
+// @Tags         admin-project-schemas
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {array}   ProjectSchemaResponse
+// @Failure      401  {object}  ErrorResponse
+// @Router       /admin/project-schemas [get]
+func (h *AdminProjectSchemaHandler) List(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	schemas, err := h.repo.List(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list project schemas")
+		return fiber.NewError(http.StatusInternalServerError, "failed to fetch project schemas")
+	}
+
+	resp := make([]ProjectSchemaResponse, 0, len(schemas))
+	for _, s := range schemas {
+		resp = append(resp, projectSchemaToResponse(s))
+	}
+
+	return c.JSON(fiber.Map{"data": resp})
+}
+
+// GET /api/v1/admin/project-schemas/:id
+// Admin Get Project Schema godoc
+// @Summary      Get project schema
+// @Tags         admin-project-schemas
+// @Security     BearerAuth
+// @Param        id   path string true "Schema ID"
+// @Success      200  {object} ProjectSchemaResponse
+// @Failure      404  {object} ErrorResponse
+// @Router       /admin/project-schemas/{id} [get]
+func (h *AdminProjectSchemaHandler) GetByID(c *fiber.Ctx) error {
+	idStr := c.Params("id")
+	if _, err := uuid.Parse(idStr); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid schema ID")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	s, err := h.repo.GetByID(ctx, idStr)
+	if err != nil {
+		return fiber.NewError(http.StatusNotFound, "project schema not found")
+	}
+
+	return c.JSON(fiber.Map{"data": projectSchemaToResponse(*s)})
+}
+
+// POST /api/v1/admin/project-schemas
+// Admin Create Project Schema godoc
+// @Summary      Register JSON Schema for a project category
+// @Tags         admin-project-schemas
+// @Security     BearerAuth
+// @Param        payload  body  ProjectSchemaCreateRequest  true  "Schema payload"
+// @Success      201      {object} ProjectSchemaResponse
+// @Failure      409      {object} ErrorResponse
+// @Failure      422      {object} ErrorResponse
+// @Router       /admin/project-schemas [post]
+func (h *AdminProjectSchemaHandler) Create(c *fiber.Ctx) error {
+	var req ProjectSchemaCreateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid JSON body")
+	}
+
+	if fieldErrors := validateProjectSchemaRequest(&req); len(fieldErrors) > 0 {
+		return sendValidationError(c, fieldErrors)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if _, err := h.repo.GetByCategory(ctx, req.Category); err == nil {
+		return fiber.NewError(http.StatusConflict, "schema for this category already exists")
+	}
+
+	s := models.ProjectSchema{
+		Category:    req.Category,
+		Description: req.Description,
+		Schema:      datatypes.JSON(req.Schema),
+	}
+
+	if err := h.repo.Create(ctx, &s); err != nil {
+		log.Error().Err(err).Msg("failed to create project schema")
+		return fiber.NewError(http.StatusInternalServerError, "failed to create project schema")
+	}
+
+	return c.Status(http.StatusCreated).JSON(fiber.Map{"data": projectSchemaToResponse(s)})
+}
+
+// PUT /api/v1/admin/project-schemas/:id
+// Admin Update Project Schema godoc
+// @Summary      Update project schema
+// @Tags         admin-project-schemas
+// @Security     BearerAuth
+// @Param        id       path string                      true "Schema ID"
+// @Param        payload  body ProjectSchemaUpdateRequest  true "Schema payload"
+// @Success      200      {object} ProjectSchemaResponse
+// @Failure      422      {object} ErrorResponse
+// @Router       /admin/project-schemas/{id} [put]
+func (h *AdminProjectSchemaHandler) Update(c *fiber.Ctx) error {
+	idStr := c.Params("id")
+	if _, err := uuid.Parse(idStr); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid schema ID")
+	}
+
+	var req ProjectSchemaUpdateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid JSON body")
+	}
+
+	if fieldErrors := validateProjectSchemaRequest(&req); len(fieldErrors) > 0 {
+		return sendValidationError(c, fieldErrors)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	s, err := h.repo.GetByID(ctx, idStr)
+	if err != nil {
+		return fiber.NewError(http.StatusNotFound, "project schema not found")
+	}
+
+	if other, err := h.repo.GetByCategory(ctx, req.Category); err == nil && other.ID != s.ID {
+		return fiber.NewError(http.StatusConflict, "schema for this category already exists")
+	}
+
+	s.Category = req.Category
+	s.Description = req.Description
+	s.Schema = datatypes.JSON(req.Schema)
+
+	if err := h.repo.Update(ctx, s); err != nil {
+		log.Error().Err(err).Msg("failed to update project schema")
+		return fiber.NewError(http.StatusInternalServerError, "failed to update project schema")
+	}
+
+	return c.JSON(fiber.Map{"data": projectSchemaToResponse(*s)})
+}
+
+// DELETE /api/v1/admin/project-schemas/:id
+// Admin Delete Project Schema godoc
+// @Summary      Delete project schema
+// @Tags         admin-project-schemas
+// @Security     BearerAuth
+// @Param        id   path string true "Schema ID"
+// @Success      204  "No Content"
+// @Router       /admin/project-schemas/{id} [delete]
+func (h *AdminProjectSchemaHandler) Delete(c *fiber.Ctx) error {
+	idStr := c.Params("id")
+	if _, err := uuid.Parse(idStr); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid schema ID")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := h.repo.Delete(ctx, idStr); err != nil {
+		log.Error().Err(err).Msg("failed to delete project schema")
+		return fiber.NewError(http.StatusInternalServerError, "failed to delete project schema")
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// Helper: validasi struct + pastikan schema bisa di-compile
+func validateProjectSchemaRequest(req *ProjectSchemaCreateRequest) map[string]string {
+	if err := validation.ValidateStruct(req); err != nil {
+		return validation.ToFieldErrors(err)
+	}
+
+	if _, err := validation.CompileJSONSchema(req.Schema); err != nil {
+		return map[string]string{"schema": "JSON Schema tidak valid: " + err.Error()}
+	}
+
+	return nil
+}
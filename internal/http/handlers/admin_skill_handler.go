@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/changes"
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/FauzanParanditha/portfolio-backend/internal/repository"
+	"github.com/FauzanParanditha/portfolio-backend/internal/validation"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+type AdminSkillHandler struct {
+	repo repository.SkillRepository
+	tags repository.TagRepository
+}
+
+func NewAdminSkillHandler(repo repository.SkillRepository, tags repository.TagRepository) *AdminSkillHandler {
+	return &AdminSkillHandler{repo: repo, tags: tags}
+}
+
+// GET /api/v1/admin/skills/overrides
+// Admin List Skill Overrides godoc
+// @Summary      List skill overrides
+// @Tags         admin-skills
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {array}   SkillOverrideResponse
+// @Failure      401  {object}  ErrorResponse
+// @Router       /admin/skills/overrides [get]
+func (h *AdminSkillHandler) List(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	rows, err := h.repo.ListOverrides(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list skill overrides")
+		return fiber.NewError(http.StatusInternalServerError, "failed to fetch skill overrides")
+	}
+
+	resp := make([]SkillOverrideResponse, 0, len(rows))
+	for _, o := range rows {
+		resp = append(resp, skillOverrideToResponse(o))
+	}
+
+	return c.JSON(fiber.Map{"data": resp})
+}
+
+// PUT /api/v1/admin/skills/overrides/:tagId
+// Admin Upsert Skill Override godoc
+// @Summary      Create or replace skill override for a tag
+// @Tags         admin-skills
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        tagId    path  string                true  "Tag ID"
+// @Param        payload  body  SkillOverrideRequest  true  "Override payload"
+// @Success      200  {object}  SkillOverrideResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      422  {object}  ErrorResponse
+// @Router       /admin/skills/overrides/{tagId} [put]
+func (h *AdminSkillHandler) Upsert(c *fiber.Ctx) error {
+	tagID, err := uuid.Parse(c.Params("tagId"))
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid tag ID")
+	}
+
+	var req SkillOverrideRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid JSON body")
+	}
+
+	if err := validation.ValidateStruct(&req); err != nil {
+		return sendValidationError(c, validation.ToFieldErrors(err))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if _, err := h.tags.GetByID(ctx, tagID.String()); err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return fiber.NewError(http.StatusNotFound, "tag not found")
+		}
+		log.Error().Err(err).Str("tag_id", tagID.String()).Msg("failed to get tag for skill override")
+		return fiber.NewError(http.StatusInternalServerError, "failed to save skill override")
+	}
+
+	o := models.SkillOverride{
+		TagID:       tagID,
+		Proficiency: req.Proficiency,
+		SortOrder:   req.SortOrder,
+		Hidden:      req.Hidden,
+		UpdatedAt:   time.Now(),
+	}
+
+	if err := h.repo.UpsertOverride(ctx, &o); err != nil {
+		log.Error().Err(err).Str("tag_id", tagID.String()).Msg("failed to upsert skill override")
+		return fiber.NewError(http.StatusInternalServerError, "failed to save skill override")
+	}
+
+	changes.Notify(changes.Skills)
+
+	return c.JSON(fiber.Map{"data": skillOverrideToResponse(o)})
+}
+
+// DELETE /api/v1/admin/skills/overrides/:tagId
+// Admin Delete Skill Override godoc
+// @Summary      Remove skill override (back to automatic values)
+// @Tags         admin-skills
+// @Security     BearerAuth
+// @Param        tagId  path  string  true  "Tag ID"
+// @Success      204  "No Content"
+// @Failure      404  {object}  ErrorResponse
+// @Router       /admin/skills/overrides/{tagId} [delete]
+func (h *AdminSkillHandler) Delete(c *fiber.Ctx) error {
+	tagID, err := uuid.Parse(c.Params("tagId"))
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid tag ID")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := h.repo.DeleteOverride(ctx, tagID.String()); err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return fiber.NewError(http.StatusNotFound, "skill override not found")
+		}
+		log.Error().Err(err).Str("tag_id", tagID.String()).Msg("failed to delete skill override")
+		return fiber.NewError(http.StatusInternalServerError, "failed to delete skill override")
+	}
+
+	changes.Notify(changes.Skills)
+
+	return c.SendStatus(http.StatusNoContent)
+}
@@ -6,20 +6,23 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/FauzanParanditha/portfolio-backend/internal/changes"
 	"github.com/FauzanParanditha/portfolio-backend/internal/models"
 	"github.com/FauzanParanditha/portfolio-backend/internal/repository"
 	"github.com/FauzanParanditha/portfolio-backend/internal/validation"
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
 )
 
 type AdminTagHandler struct {
 	repo repository.TagRepository
+	db   *gorm.DB // reorder massal
 }
 
-func NewAdminTagHandler(repo repository.TagRepository) *AdminTagHandler {
-	return &AdminTagHandler{repo: repo}
+func NewAdminTagHandler(repo repository.TagRepository, db *gorm.DB) *AdminTagHandler {
+	return &AdminTagHandler{repo: repo, db: db}
 }
 
 // GET /api/v1/admin/tags
@@ -27,9 +30,11 @@ func NewAdminTagHandler(repo repository.TagRepository) *AdminTagHandler {
 // @Summary      List tags
 // @Tags         admin-tags
 // @Security     BearerAuth
-// @Param        q     query  string false "Search"
-// @Param        page  query  int    false "Page number"
-// @Param        limit query  int    false "Page size"
+// @Param        q          query  string false "Search"
+// @Param        page       query  int    false "Page number"
+// @Param        limit      query  int    false "Page size"
+// @Param        cursor     query  string false "Opaque cursor from meta.nextCursor / meta.prevCursor"
+// @Param        withTotal  query  bool   false "Include total count (default false)"
 // @Success      200  {array}  TagResponse
 // @Failure      401  {object} ErrorResponse
 // @Router       /admin/tags [get]
@@ -45,16 +50,24 @@ func (h *AdminTagHandler) List(c *fiber.Ctx) error {
 		limit = 20
 	}
 
+	list := cursorList(c, "admin-tags")
+	cursor, err := parseCursorQuery(c, list)
+	if err != nil {
+		return err
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
 	params := repository.TagListParams{
-		Query: q,
-		Page:  page,
-		Limit: limit,
+		Query:     q,
+		Page:      page,
+		Limit:     limit,
+		Cursor:    cursor,
+		WithTotal: withTotalQuery(c),
 	}
 
-	tags, total, err := h.repo.List(ctx, params)
+	tags, info, err := h.repo.List(ctx, params)
 	if err != nil {
 		log.Error().Err(err).Msg("failed to list tags")
 		return fiber.NewError(http.StatusInternalServerError, "failed to fetch tags")
@@ -65,15 +78,23 @@ func (h *AdminTagHandler) List(c *fiber.Ctx) error {
 		resp = append(resp, tagToResponse(t))
 	}
 
+	next, prev := cursorLinks(list, tags, info, tagCursor)
+
+	meta := fiber.Map{
+		"page":       page,
+		"limit":      limit,
+		"hasMore":    info.HasNext,
+		"nextCursor": next,
+		"prevCursor": prev,
+		"q":          q,
+	}
+	if info.Total != nil {
+		meta["total"] = *info.Total
+	}
+
 	return c.JSON(fiber.Map{
 		"data": resp,
-		"meta": fiber.Map{
-			"page":    page,
-			"limit":   limit,
-			"total":   total,
-			"hasMore": int64(page*limit) < total,
-			"q":       q,
-		},
+		"meta": meta,
 	})
 }
 
@@ -140,6 +161,8 @@ func (h *AdminTagHandler) Create(c *fiber.Ctx) error {
 		return fiber.NewError(http.StatusInternalServerError, "failed to create tag")
 	}
 
+	changes.Notify(changes.Tags)
+
 	return c.Status(http.StatusCreated).JSON(fiber.Map{"data": tagToResponse(tag)})
 }
 
@@ -190,6 +213,8 @@ func (h *AdminTagHandler) Update(c *fiber.Ctx) error {
 		return fiber.NewError(http.StatusInternalServerError, "failed to update tag")
 	}
 
+	changes.Notify(changes.Tags)
+
 	return c.JSON(fiber.Map{"data": tagToResponse(*tag)})
 }
 
@@ -217,5 +242,7 @@ func (h *AdminTagHandler) Delete(c *fiber.Ctx) error {
 		return fiber.NewError(http.StatusInternalServerError, "failed to delete tag")
 	}
 
+	changes.Notify(changes.Tags)
+
 	return c.SendStatus(http.StatusNoContent)
 }
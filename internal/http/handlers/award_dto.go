@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+)
+
+type AwardResponse struct {
+	ID          string        `json:"id"`
+	Title       string        `json:"title"`
+	Issuer      string        `json:"issuer,omitempty"`
+	AwardDate   string        `json:"awardDate"` // "2006-01-02"
+	URL         string        `json:"url,omitempty"`
+	Description string        `json:"description,omitempty"`
+	SortOrder   int           `json:"sortOrder"`
+	Tags        []TagResponse `json:"tags"`
+}
+
+type AwardCreateRequest struct {
+	Title       string   `json:"title" validate:"required,max=255"`
+	Issuer      string   `json:"issuer" validate:"max=255"`
+	AwardDate   string   `json:"awardDate" validate:"required,datetime=2006-01-02"`
+	URL         string   `json:"url" validate:"omitempty,url"`
+	Description string   `json:"description"`
+	SortOrder   int      `json:"sortOrder"`
+	TagIDs      []string `json:"tagIds"` // UUID string
+}
+
+type AwardUpdateRequest = AwardCreateRequest
+
+func awardToResponse(a models.Award) AwardResponse {
+	tags := make([]TagResponse, 0, len(a.Tags))
+	for _, t := range a.Tags {
+		tags = append(tags, tagToResponse(t))
+	}
+
+	return AwardResponse{
+		ID:          a.ID.String(),
+		Title:       a.Title,
+		Issuer:      a.Issuer,
+		AwardDate:   a.AwardDate.Format("2006-01-02"),
+		URL:         a.URL,
+		Description: a.Description,
+		SortOrder:   a.SortOrder,
+		Tags:        tags,
+	}
+}
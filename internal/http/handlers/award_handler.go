@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/changes"
+	"github.com/FauzanParanditha/portfolio-backend/internal/repository"
+	"github.com/gofiber/fiber/v2"
+	"github.com/rs/zerolog/log"
+)
+
+type AwardHandler struct {
+	repo repository.AwardRepository
+}
+
+func NewAwardHandler(repo repository.AwardRepository) *AwardHandler {
+	return &AwardHandler{repo: repo}
+}
+
+type AwardListResponse struct {
+	Data []AwardResponse `json:"data"`
+	Meta interface{}     `json:"meta"`
+}
+
+// GET /api/v1/awards?tag=hackathon
+// Public List Awards godoc
+// @Summary      Get awards
+// @Description  List awards ordered by sortOrder, then most recent award date
+// @Tags         awards
+// @Produce      json
+// @Param        tag  query  string  false  "Filter by tag name"
+// @Param        If-None-Match      header  string  false  "ETag from a previous response"
+// @Param        If-Modified-Since  header  string  false  "Last-Modified from a previous response"
+// @Success      200  {object}  AwardListResponse
+// @Success      304  "Not Modified"
+// @Failure      500  {object}  ErrorResponse
+// @Router       /awards [get]
+func (h *AwardHandler) List(c *fiber.Ctx) error {
+	params := repository.AwardListParams{
+		Tag: strings.TrimSpace(c.Query("tag")),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	items, err := h.repo.ListPublic(ctx, params)
+	if err != nil {
+		log.Error().Err(err).Str("tag", params.Tag).Msg("failed to list awards (public)")
+		return fiber.NewError(http.StatusInternalServerError, "failed to fetch awards")
+	}
+
+	resp := make([]AwardResponse, 0, len(items))
+	updated := make([]time.Time, 0, len(items))
+	for _, a := range items {
+		resp = append(resp, awardToResponse(a))
+		updated = append(updated, a.UpdatedAt)
+	}
+
+	return sendConditionalJSON(c, fiber.Map{
+		"data": resp,
+		"meta": fiber.Map{
+			"tag":   params.Tag,
+			"total": len(resp),
+		},
+	}, lastModified(updated, changes.Awards, changes.Tags))
+}
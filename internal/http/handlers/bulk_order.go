@@ -0,0 +1,220 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/changes"
+	"github.com/FauzanParanditha/portfolio-backend/internal/validation"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Reorder massal project / experience / tag (drag & drop di admin).
+// Tanpa scope: ids = semua baris. Dengan scope (mis. featured): ids = semua baris di scope,
+// baris di luar scope tetap di posisinya. Hasil akhirnya selalu sort_order 0..n-1 tanpa duplikat.
+
+// orderTable: tabel top-level yang punya sort_order
+type orderTable struct {
+	table   string
+	name    string            // untuk log / pesan error
+	orderBy string            // urutan tampil sekarang (sama dengan list public)
+	scopes  map[string]string // ?scope= → kondisi SQL (konstanta)
+	topics  []changes.Topic
+}
+
+var (
+	projectOrderTable = orderTable{
+		table:   "projects",
+		name:    "projects",
+		orderBy: "sort_order ASC, created_at DESC, id DESC",
+		scopes: map[string]string{
+			"featured": "is_featured = true",
+		},
+		topics: []changes.Topic{changes.Projects, changes.Experiences},
+	}
+	experienceOrderTable = orderTable{
+		table:   "experiences",
+		name:    "experiences",
+		orderBy: "sort_order ASC, start_date DESC, id DESC",
+		scopes: map[string]string{
+			"current": "is_current = true",
+		},
+		topics: []changes.Topic{changes.Experiences, changes.Projects},
+	}
+	tagOrderTable = orderTable{
+		table:   "tags",
+		name:    "tags",
+		orderBy: "sort_order ASC, created_at DESC, id DESC",
+		topics:  []changes.Topic{changes.Tags},
+	}
+)
+
+type OrderItem struct {
+	ID        string `json:"id"`
+	SortOrder int    `json:"sortOrder"`
+}
+
+type BulkReorderResponse struct {
+	Data    []OrderItem `json:"data"`    // semua baris dalam urutan baru
+	Updated int64       `json:"updated"` // baris yang sort_order-nya berubah
+	Scope   string      `json:"scope,omitempty"`
+}
+
+func (t orderTable) reorder(c *fiber.Ctx, db *gorm.DB) error {
+	scope := c.Query("scope")
+	cond := "true"
+	if scope != "" {
+		var ok bool
+		if cond, ok = t.scopes[scope]; !ok {
+			return fiber.NewError(http.StatusBadRequest, "invalid scope: "+scope)
+		}
+	}
+
+	var req ReorderRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid JSON body")
+	}
+	if err := validation.ValidateStruct(&req); err != nil {
+		return sendValidationError(c, validation.ToFieldErrors(err))
+	}
+
+	ids, err := parseUUIDs(req.IDs)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid ids")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	tx := db.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// kunci semua baris: create / reorder lain menunggu sampai commit
+	var rows []struct {
+		ID      uuid.UUID
+		InScope bool
+	}
+	if err := tx.Table(t.table).
+		Select("id, (" + cond + ") AS in_scope").
+		Order(t.orderBy).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Scan(&rows).Error; err != nil {
+
+		tx.Rollback()
+		log.Error().Err(err).Str("table", t.table).Msg("failed to load rows for reorder")
+		return fiber.NewError(http.StatusInternalServerError, "failed to reorder "+t.name)
+	}
+
+	scoped := make([]uuid.UUID, 0, len(rows))
+	for _, r := range rows {
+		if r.InScope {
+			scoped = append(scoped, r.ID)
+		}
+	}
+
+	if msg := orderIDsError(scoped, ids); msg != "" {
+		tx.Rollback()
+		return sendValidationError(c, map[string]string{"ids": msg})
+	}
+
+	// slot milik scope diisi sesuai ids, sisanya tetap
+	final := make([]uuid.UUID, 0, len(rows))
+	next := 0
+	for _, r := range rows {
+		if r.InScope {
+			final = append(final, ids[next])
+			next++
+		} else {
+			final = append(final, r.ID)
+		}
+	}
+
+	updated, err := applyOrder(tx, t.table, final)
+	if err != nil {
+		tx.Rollback()
+		log.Error().Err(err).Str("table", t.table).Msg("failed to apply order")
+		return fiber.NewError(http.StatusInternalServerError, "failed to reorder "+t.name)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		log.Error().Err(err).Str("table", t.table).Msg("failed to commit reorder")
+		return fiber.NewError(http.StatusInternalServerError, "failed to reorder "+t.name)
+	}
+
+	if updated > 0 {
+		changes.Notify(t.topics...)
+	}
+
+	items := make([]OrderItem, 0, len(final))
+	for i, id := range final {
+		items = append(items, OrderItem{ID: id.String(), SortOrder: i})
+	}
+
+	return c.JSON(BulkReorderResponse{
+		Data:    items,
+		Updated: updated,
+		Scope:   scope,
+	})
+}
+
+// PUT /api/v1/admin/projects/order?scope=featured
+// Admin Reorder Projects godoc
+// @Summary      Reorder projects
+// @Description  Renumbers sort_order in one transaction. Without scope, ids must list every project exactly once. With scope=featured, ids must list every featured project; the others keep their positions.
+// @Tags         admin-projects
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        scope    query  string          false  "featured"
+// @Param        payload  body   ReorderRequest  true   "Project IDs in display order"
+// @Success      200  {object}  BulkReorderResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      422  {object}  ErrorResponse
+// @Router       /admin/projects/order [put]
+func (h *AdminProjectHandler) Reorder(c *fiber.Ctx) error {
+	return projectOrderTable.reorder(c, h.db)
+}
+
+// PUT /api/v1/admin/experiences/order?scope=current
+// Admin Reorder Experiences godoc
+// @Summary      Reorder experiences
+// @Description  Renumbers sort_order in one transaction. Without scope, ids must list every experience exactly once. With scope=current, ids must list every current role; the others keep their positions.
+// @Tags         admin-experiences
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        scope    query  string          false  "current"
+// @Param        payload  body   ReorderRequest  true   "Experience IDs in display order"
+// @Success      200  {object}  BulkReorderResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      422  {object}  ErrorResponse
+// @Router       /admin/experiences/order [put]
+func (h *AdminExperienceHandler) Reorder(c *fiber.Ctx) error {
+	return experienceOrderTable.reorder(c, h.db)
+}
+
+// PUT /api/v1/admin/tags/order
+// Admin Reorder Tags godoc
+// @Summary      Reorder tags
+// @Description  Renumbers sort_order in one transaction. ids must list every tag exactly once.
+// @Tags         admin-tags
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        payload  body  ReorderRequest  true  "Tag IDs in display order"
+// @Success      200  {object}  BulkReorderResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      422  {object}  ErrorResponse
+// @Router       /admin/tags/order [put]
+func (h *AdminTagHandler) Reorder(c *fiber.Ctx) error {
+	return tagOrderTable.reorder(c, h.db)
+}
@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+)
+
+type CertificationResponse struct {
+	ID            string        `json:"id"`
+	Name          string        `json:"name"`
+	Issuer        string        `json:"issuer"`
+	IssueDate     string        `json:"issueDate"`            // "2006-01-02"
+	ExpiryDate    *string       `json:"expiryDate,omitempty"` // kosong = tidak kedaluwarsa
+	IsExpired     bool          `json:"isExpired"`
+	CredentialID  string        `json:"credentialId,omitempty"`
+	CredentialURL string        `json:"credentialUrl,omitempty"`
+	Description   string        `json:"description,omitempty"`
+	SortOrder     int           `json:"sortOrder"`
+	Tags          []TagResponse `json:"tags"`
+}
+
+type CertificationCreateRequest struct {
+	Name          string   `json:"name" validate:"required,max=255"`
+	Issuer        string   `json:"issuer" validate:"required,max=255"`
+	IssueDate     string   `json:"issueDate" validate:"required,datetime=2006-01-02"`
+	ExpiryDate    *string  `json:"expiryDate" validate:"omitempty,datetime=2006-01-02"` // nullable
+	CredentialID  string   `json:"credentialId" validate:"max=255"`
+	CredentialURL string   `json:"credentialUrl" validate:"omitempty,url"`
+	Description   string   `json:"description"`
+	SortOrder     int      `json:"sortOrder"`
+	TagIDs        []string `json:"tagIds"` // UUID string
+}
+
+type CertificationUpdateRequest = CertificationCreateRequest
+
+func certificationToResponse(c models.Certification, now time.Time) CertificationResponse {
+	tags := make([]TagResponse, 0, len(c.Tags))
+	for _, t := range c.Tags {
+		tags = append(tags, tagToResponse(t))
+	}
+
+	var expiry *string
+	if c.ExpiryDate != nil {
+		s := c.ExpiryDate.Format("2006-01-02")
+		expiry = &s
+	}
+
+	return CertificationResponse{
+		ID:            c.ID.String(),
+		Name:          c.Name,
+		Issuer:        c.Issuer,
+		IssueDate:     c.IssueDate.Format("2006-01-02"),
+		ExpiryDate:    expiry,
+		IsExpired:     c.IsExpired(now),
+		CredentialID:  c.CredentialID,
+		CredentialURL: c.CredentialURL,
+		Description:   c.Description,
+		SortOrder:     c.SortOrder,
+		Tags:          tags,
+	}
+}
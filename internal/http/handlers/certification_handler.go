@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/changes"
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/FauzanParanditha/portfolio-backend/internal/repository"
+	"github.com/gofiber/fiber/v2"
+	"github.com/rs/zerolog/log"
+)
+
+type CertificationHandler struct {
+	repo repository.CertificationRepository
+}
+
+func NewCertificationHandler(repo repository.CertificationRepository) *CertificationHandler {
+	return &CertificationHandler{repo: repo}
+}
+
+type CertificationListResponse struct {
+	Data []CertificationResponse `json:"data"`
+	Meta interface{}             `json:"meta"`
+}
+
+// GET /api/v1/certifications?tag=aws&expired=false
+// Public List Certifications godoc
+// @Summary      Get certifications
+// @Description  List certifications. isExpired is true once expiryDate has passed; expired=false hides those.
+// @Tags         certifications
+// @Produce      json
+// @Param        tag      query  string  false  "Filter by tag name"
+// @Param        expired  query  bool    false  "false = hide expired certifications"
+// @Param        If-None-Match      header  string  false  "ETag from a previous response"
+// @Param        If-Modified-Since  header  string  false  "Last-Modified from a previous response"
+// @Success      200  {object}  CertificationListResponse
+// @Success      304  "Not Modified"
+// @Failure      500  {object}  ErrorResponse
+// @Router       /certifications [get]
+func (h *CertificationHandler) List(c *fiber.Ctx) error {
+	now := time.Now()
+	params := repository.CertificationListParams{
+		Tag:            strings.TrimSpace(c.Query("tag")),
+		ExcludeExpired: c.Query("expired") == "false",
+		Today:          models.CertificationToday(now),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	items, err := h.repo.ListPublic(ctx, params)
+	if err != nil {
+		log.Error().Err(err).Str("tag", params.Tag).Msg("failed to list certifications (public)")
+		return fiber.NewError(http.StatusInternalServerError, "failed to fetch certifications")
+	}
+
+	resp := make([]CertificationResponse, 0, len(items))
+	updated := make([]time.Time, 0, len(items))
+	for _, cert := range items {
+		resp = append(resp, certificationToResponse(cert, now))
+		updated = append(updated, cert.UpdatedAt)
+
+		// isExpired berubah tanpa ada write → geser Last-Modified ke saat mulai expired
+		if cert.IsExpired(now) {
+			updated = append(updated, cert.ExpiryDate.AddDate(0, 0, 1))
+		}
+	}
+
+	return sendConditionalJSON(c, fiber.Map{
+		"data": resp,
+		"meta": fiber.Map{
+			"tag":     params.Tag,
+			"expired": !params.ExcludeExpired,
+			"total":   len(resp),
+		},
+	}, lastModified(updated, changes.Certifications, changes.Tags))
+}
@@ -0,0 +1,491 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/changes"
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/FauzanParanditha/portfolio-backend/internal/validation"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Helper untuk baris anak berurutan (project feature / screenshot, experience highlight).
+// Nama tabel & kolom selalu konstanta dari handler, bukan input user.
+
+// childAccess: cara baca / tulis nilai & urutan untuk satu jenis baris anak
+type childAccess[T any] struct {
+	value     func(*T) string
+	sortOrder func(*T) int
+	set       func(item *T, value string, sortOrder int)
+	create    func(parentID uuid.UUID, value string, sortOrder int) T
+	// editInPlace: nilai baru di posisi yang sama dianggap edit baris lama (teks),
+	// bukan item baru (mis. screenshot dengan URL lain = screenshot lain)
+	editInPlace bool
+}
+
+var featureAccess = childAccess[models.ProjectFeature]{
+	value:     func(f *models.ProjectFeature) string { return f.Text },
+	sortOrder: func(f *models.ProjectFeature) int { return f.SortOrder },
+	set: func(f *models.ProjectFeature, v string, i int) {
+		f.Text = v
+		f.SortOrder = i
+	},
+	create: func(pid uuid.UUID, v string, i int) models.ProjectFeature {
+		return models.ProjectFeature{ProjectID: pid, Text: v, SortOrder: i}
+	},
+	editInPlace: true,
+}
+
+var screenshotAccess = childAccess[models.ProjectScreenshot]{
+	value:     func(s *models.ProjectScreenshot) string { return s.ImageURL },
+	sortOrder: func(s *models.ProjectScreenshot) int { return s.SortOrder },
+	set: func(s *models.ProjectScreenshot, v string, i int) {
+		s.ImageURL = v
+		s.SortOrder = i
+	},
+	create: func(pid uuid.UUID, v string, i int) models.ProjectScreenshot {
+		return models.ProjectScreenshot{ProjectID: pid, ImageURL: v, SortOrder: i}
+	},
+}
+
+var highlightAccess = childAccess[models.ExperienceHighlight]{
+	value:     func(h *models.ExperienceHighlight) string { return h.Text },
+	sortOrder: func(h *models.ExperienceHighlight) int { return h.SortOrder },
+	set: func(h *models.ExperienceHighlight, v string, i int) {
+		h.Text = v
+		h.SortOrder = i
+	},
+	create: func(eid uuid.UUID, v string, i int) models.ExperienceHighlight {
+		return models.ExperienceHighlight{ExperienceID: eid, Text: v, SortOrder: i}
+	},
+	editInPlace: true,
+}
+
+// syncOrdered: samakan baris anak dengan list nilai dari PUT parent tanpa mengganti ID.
+// Nilai yang sama dengan baris lama memakai baris itu (jadi reorder tidak mengubah ID).
+// Kalau acc.editInPlace, nilai baru memakai baris lama di posisi yang sama kalau baris itu
+// belum terpakai (edit di tempat); sisanya di-insert / dihapus, jadi ID tidak pernah
+// pindah ke item lain. existing harus urut sort_order; nilai kosong dibuang.
+func syncOrdered[T any](tx *gorm.DB, acc childAccess[T], parentID uuid.UUID, existing []T, values []string) error {
+	values = nonEmpty(values)
+
+	used := make([]bool, len(existing))
+	assigned := make([]int, len(values))
+	for i := range assigned {
+		assigned[i] = -1
+	}
+
+	// 1. nilai yang tidak berubah
+	for i, v := range values {
+		for j := range existing {
+			if !used[j] && acc.value(&existing[j]) == v {
+				used[j] = true
+				assigned[i] = j
+				break
+			}
+		}
+	}
+
+	// 2. nilai yang diedit memakai baris lama di posisi yang sama
+	if acc.editInPlace {
+		for i := range values {
+			if assigned[i] < 0 && i < len(existing) && !used[i] {
+				used[i] = true
+				assigned[i] = i
+			}
+		}
+	}
+
+	for j := range existing {
+		if !used[j] {
+			if err := tx.Delete(&existing[j]).Error; err != nil {
+				return err
+			}
+		}
+	}
+
+	for i, v := range values {
+		if assigned[i] < 0 {
+			item := acc.create(parentID, v, i)
+			if err := tx.Create(&item).Error; err != nil {
+				return err
+			}
+			continue
+		}
+
+		item := &existing[assigned[i]]
+		if acc.value(item) == v && acc.sortOrder(item) == i {
+			continue
+		}
+		acc.set(item, v, i)
+		if err := tx.Save(item).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// nextSortOrder: posisi untuk item yang ditambahkan di akhir
+func nextSortOrder(tx *gorm.DB, table, parentCol string, parentID uuid.UUID) (int, error) {
+	var n int
+	err := tx.Table(table).
+		Where(parentCol+" = ?", parentID).
+		Select("COALESCE(MAX(sort_order) + 1, 0)").
+		Scan(&n).Error
+	return n, err
+}
+
+// renumber: rapatkan sort_order jadi 0..n-1 (mis. setelah delete)
+func renumber(tx *gorm.DB, table, parentCol string, parentID uuid.UUID) error {
+	return tx.Exec(`UPDATE `+table+` t SET sort_order = r.pos
+		FROM (SELECT id, row_number() OVER (ORDER BY sort_order, id) - 1 AS pos FROM `+table+` WHERE `+parentCol+` = ?) r
+		WHERE t.id = r.id AND t.sort_order <> r.pos`, parentID).Error
+}
+
+// orderIDsError: ids harus persis berisi semua ID yang sekarang ada (tanpa duplikat,
+// tanpa ID asing, tanpa yang terlewat). "" = valid.
+func orderIDsError(current, ids []uuid.UUID) string {
+	known := make(map[uuid.UUID]bool, len(current))
+	for _, id := range current {
+		known[id] = true
+	}
+
+	seen := make(map[uuid.UUID]bool, len(ids))
+	var unknown, dup []string
+	for _, id := range ids {
+		if seen[id] {
+			dup = append(dup, id.String())
+			continue
+		}
+		seen[id] = true
+		if !known[id] {
+			unknown = append(unknown, id.String())
+		}
+	}
+
+	var missing []string
+	for _, id := range current {
+		if !seen[id] {
+			missing = append(missing, id.String())
+		}
+	}
+
+	var msgs []string
+	if len(unknown) > 0 {
+		msgs = append(msgs, "ID tidak dikenal: "+strings.Join(unknown, ", "))
+	}
+	if len(dup) > 0 {
+		msgs = append(msgs, "ID duplikat: "+strings.Join(dup, ", "))
+	}
+	if len(missing) > 0 {
+		msgs = append(msgs, "ID belum disebut: "+strings.Join(missing, ", "))
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// applyOrder: sort_order = posisi di ids (0-based), satu statement. ids sudah dicek orderIDsError.
+// Mengembalikan jumlah baris yang urutannya berubah.
+func applyOrder(tx *gorm.DB, table string, ids []uuid.UUID) (int64, error) {
+	strs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		strs = append(strs, id.String())
+	}
+
+	res := tx.Exec(`UPDATE `+table+` t SET sort_order = o.pos - 1
+		FROM unnest(?::uuid[]) WITH ORDINALITY AS o(id, pos)
+		WHERE t.id = o.id AND t.sort_order <> o.pos - 1`, pq.Array(strs))
+	return res.RowsAffected, res.Error
+}
+
+// touch: geser updated_at parent supaya Last-Modified / sitemap ikut berubah
+func touch(tx *gorm.DB, table string, id uuid.UUID) error {
+	return tx.Table(table).Where("id = ?", id).Update("updated_at", time.Now()).Error
+}
+
+// ---------------------------------------------------------
+// sub-resource endpoint (dipakai admin project & experience)
+// ---------------------------------------------------------
+
+// childRoute: satu jenis anak di bawah parent, mis. /admin/projects/:id/features/:childId
+type childRoute struct {
+	table       string // "project_features"
+	parentTable string // "projects"
+	parentCol   string // "project_id"
+	parentName  string // untuk pesan error: "project"
+	childName   string // "feature"
+	topics      []changes.Topic
+}
+
+var (
+	featureRoute = childRoute{
+		table: "project_features", parentTable: "projects", parentCol: "project_id",
+		parentName: "project", childName: "feature",
+		topics: []changes.Topic{changes.Projects, changes.Experiences},
+	}
+	screenshotRoute = childRoute{
+		table: "project_screenshots", parentTable: "projects", parentCol: "project_id",
+		parentName: "project", childName: "screenshot",
+		topics: []changes.Topic{changes.Projects, changes.Experiences},
+	}
+	highlightRoute = childRoute{
+		table: "experience_highlights", parentTable: "experiences", parentCol: "experience_id",
+		parentName: "experience", childName: "highlight",
+		topics: []changes.Topic{changes.Experiences, changes.Projects},
+	}
+)
+
+func (r childRoute) ids(c *fiber.Ctx, withChild bool) (parentID, childID uuid.UUID, err error) {
+	parentID, err = uuid.Parse(c.Params("id"))
+	if err != nil {
+		return parentID, childID, fiber.NewError(http.StatusBadRequest, "invalid "+r.parentName+" ID")
+	}
+	if withChild {
+		childID, err = uuid.Parse(c.Params("childId"))
+		if err != nil {
+			return parentID, childID, fiber.NewError(http.StatusBadRequest, "invalid "+r.childName+" ID")
+		}
+	}
+	return parentID, childID, nil
+}
+
+// lockParent: pastikan parent ada & kunci barisnya, supaya urutan anak tidak
+// diubah dua request sekaligus
+func (r childRoute) lockParent(tx *gorm.DB, parentID uuid.UUID) error {
+	var ids []uuid.UUID
+	if err := tx.Table(r.parentTable).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", parentID).
+		Pluck("id", &ids).Error; err != nil {
+		return err
+	}
+	if len(ids) == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// commit: touch parent + commit + Notify
+func (r childRoute) commit(tx *gorm.DB, parentID uuid.UUID) error {
+	if err := touch(tx, r.parentTable, parentID); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Commit().Error; err != nil {
+		return err
+	}
+	changes.Notify(r.topics...)
+	return nil
+}
+
+func (r childRoute) failed(err error, action string) error {
+	if err == gorm.ErrRecordNotFound {
+		return fiber.NewError(http.StatusNotFound, r.parentName+" not found")
+	}
+	log.Error().Err(err).Str("table", r.table).Msg("failed to " + action + " " + r.childName)
+	return fiber.NewError(http.StatusInternalServerError, "failed to "+action+" "+r.childName)
+}
+
+// createChild: tambah anak di posisi paling akhir
+func createChild[T any](c *fiber.Ctx, db *gorm.DB, r childRoute, acc childAccess[T], value string, toResp func(T) any) error {
+	parentID, _, err := r.ids(c, false)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	tx := db.WithContext(ctx).Begin()
+	defer func() {
+		if rec := recover(); rec != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := r.lockParent(tx, parentID); err != nil {
+		tx.Rollback()
+		return r.failed(err, "create")
+	}
+
+	pos, err := nextSortOrder(tx, r.table, r.parentCol, parentID)
+	if err != nil {
+		tx.Rollback()
+		return r.failed(err, "create")
+	}
+
+	item := acc.create(parentID, value, pos)
+	if err := tx.Create(&item).Error; err != nil {
+		tx.Rollback()
+		return r.failed(err, "create")
+	}
+
+	if err := r.commit(tx, parentID); err != nil {
+		return r.failed(err, "create")
+	}
+
+	return c.Status(http.StatusCreated).JSON(fiber.Map{"data": toResp(item)})
+}
+
+// updateChild: ganti nilai satu anak, ID & posisi tetap
+func updateChild[T any](c *fiber.Ctx, db *gorm.DB, r childRoute, acc childAccess[T], value string, toResp func(T) any) error {
+	parentID, childID, err := r.ids(c, true)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	tx := db.WithContext(ctx).Begin()
+	defer func() {
+		if rec := recover(); rec != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var item T
+	if err := tx.Where("id = ? AND "+r.parentCol+" = ?", childID, parentID).First(&item).Error; err != nil {
+		tx.Rollback()
+		if err == gorm.ErrRecordNotFound {
+			return fiber.NewError(http.StatusNotFound, r.childName+" not found")
+		}
+		return r.failed(err, "update")
+	}
+
+	acc.set(&item, value, acc.sortOrder(&item))
+	if err := tx.Save(&item).Error; err != nil {
+		tx.Rollback()
+		return r.failed(err, "update")
+	}
+
+	if err := r.commit(tx, parentID); err != nil {
+		return r.failed(err, "update")
+	}
+
+	return c.JSON(fiber.Map{"data": toResp(item)})
+}
+
+// deleteChild: hapus satu anak lalu rapatkan urutan sisanya
+func deleteChild[T any](c *fiber.Ctx, db *gorm.DB, r childRoute) error {
+	parentID, childID, err := r.ids(c, true)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	tx := db.WithContext(ctx).Begin()
+	defer func() {
+		if rec := recover(); rec != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := r.lockParent(tx, parentID); err != nil {
+		tx.Rollback()
+		return r.failed(err, "delete")
+	}
+
+	res := tx.Where("id = ? AND "+r.parentCol+" = ?", childID, parentID).Delete(new(T))
+	if res.Error != nil {
+		tx.Rollback()
+		return r.failed(res.Error, "delete")
+	}
+	if res.RowsAffected == 0 {
+		tx.Rollback()
+		return fiber.NewError(http.StatusNotFound, r.childName+" not found")
+	}
+
+	if err := renumber(tx, r.table, r.parentCol, parentID); err != nil {
+		tx.Rollback()
+		return r.failed(err, "delete")
+	}
+
+	if err := r.commit(tx, parentID); err != nil {
+		return r.failed(err, "delete")
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// reorderChildren: body berisi semua ID anak dalam urutan baru
+func reorderChildren[T any](c *fiber.Ctx, db *gorm.DB, r childRoute, toResp func(T) any) error {
+	parentID, _, err := r.ids(c, false)
+	if err != nil {
+		return err
+	}
+
+	var req ReorderRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid JSON body")
+	}
+	if err := validation.ValidateStruct(&req); err != nil {
+		return sendValidationError(c, validation.ToFieldErrors(err))
+	}
+
+	ids, err := parseUUIDs(req.IDs)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid ids")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	tx := db.WithContext(ctx).Begin()
+	defer func() {
+		if rec := recover(); rec != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := r.lockParent(tx, parentID); err != nil {
+		tx.Rollback()
+		return r.failed(err, "reorder")
+	}
+
+	var current []uuid.UUID
+	if err := tx.Table(r.table).Where(r.parentCol+" = ?", parentID).Pluck("id", &current).Error; err != nil {
+		tx.Rollback()
+		return r.failed(err, "reorder")
+	}
+
+	if msg := orderIDsError(current, ids); msg != "" {
+		tx.Rollback()
+		return sendValidationError(c, map[string]string{"ids": msg})
+	}
+
+	if _, err := applyOrder(tx, r.table, ids); err != nil {
+		tx.Rollback()
+		return r.failed(err, "reorder")
+	}
+
+	if err := r.commit(tx, parentID); err != nil {
+		return r.failed(err, "reorder")
+	}
+
+	var items []T
+	if err := db.WithContext(ctx).
+		Where(r.parentCol+" = ?", parentID).
+		Order("sort_order ASC").
+		Find(&items).Error; err != nil {
+
+		log.Error().Err(err).Str("table", r.table).Msg("failed to reload reordered items")
+	}
+
+	resp := make([]any, 0, len(items))
+	for _, it := range items {
+		resp = append(resp, toResp(it))
+	}
+
+	return c.JSON(fiber.Map{"data": resp})
+}
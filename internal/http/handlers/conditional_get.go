@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/changes"
+	"github.com/gofiber/fiber/v2"
+	"github.com/rs/zerolog/log"
+)
+
+// lastModified: updated_at terbaru dari konten, minimal LastModified topic terkait
+// (supaya delete / perubahan relasi seperti tag juga menggeser Last-Modified).
+// Waktu topic berasal dari tabel content_changes (disalin ke memori oleh changes.Watch),
+// jadi sama di semua instance & tidak berubah saat restart.
+// Zero kalau tidak ada data sama sekali.
+func lastModified(updated []time.Time, topics ...changes.Topic) time.Time {
+	latest := changes.LastModified(topics...)
+	for _, t := range updated {
+		if t.After(latest) {
+			latest = t
+		}
+	}
+	return latest.UTC().Truncate(time.Second)
+}
+
+// sendConditionalJSON: kirim body JSON dengan ETag (strong, dari isi body saja)
+// dan Last-Modified. Balas 304 kalau If-None-Match / If-Modified-Since cocok.
+func sendConditionalJSON(c *fiber.Ctx, body any, modified time.Time) error {
+	b, err := json.Marshal(body)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to encode response")
+		return fiber.NewError(http.StatusInternalServerError, "failed to encode response")
+	}
+
+	return sendConditional(c, b, fiber.MIMEApplicationJSON, modified)
+}
+
+// sendConditional: sama seperti sendConditionalJSON untuk body yang sudah di-encode (XML, feed, dll)
+func sendConditional(c *fiber.Ctx, b []byte, contentType string, modified time.Time) error {
+	h := sha256.New()
+	h.Write(b)
+	etag := `"` + hex.EncodeToString(h.Sum(nil))[:32] + `"`
+
+	c.Set(fiber.HeaderETag, etag)
+	if !modified.IsZero() {
+		c.Set(fiber.HeaderLastModified, modified.Format(http.TimeFormat))
+	}
+
+	if notModified(c, etag, modified) {
+		return c.SendStatus(http.StatusNotModified)
+	}
+
+	c.Set(fiber.HeaderContentType, contentType)
+	return c.Send(b)
+}
+
+// notModified: If-None-Match diutamakan; If-Modified-Since hanya dipakai kalau
+// If-None-Match tidak dikirim (RFC 9110 13.2.2).
+func notModified(c *fiber.Ctx, etag string, modified time.Time) bool {
+	if inm := c.Get(fiber.HeaderIfNoneMatch); inm != "" {
+		for _, tag := range strings.Split(inm, ",") {
+			tag = strings.TrimSpace(tag)
+			if tag == "*" || strings.TrimPrefix(tag, "W/") == etag {
+				return true
+			}
+		}
+		return false
+	}
+
+	if ims := c.Get(fiber.HeaderIfModifiedSince); ims != "" && !modified.IsZero() {
+		t, err := http.ParseTime(ims)
+		if err == nil && !modified.After(t) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -1,6 +1,9 @@
 package handlers
 
-import "github.com/FauzanParanditha/portfolio-backend/internal/models"
+import (
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/FauzanParanditha/portfolio-backend/internal/pagination"
+)
 
 type ContactCreateRequest struct {
 	Name    string `json:"name" validate:"required"`
@@ -30,3 +33,8 @@ func contactToResponse(m models.ContactMessage) ContactMessageResponse {
 		CreatedAt: m.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 	}
 }
+
+// sort key contact message untuk cursor pagination (tanpa sort_order)
+func contactCursor(m models.ContactMessage) pagination.Cursor {
+	return pagination.Cursor{CreatedAt: m.CreatedAt, ID: m.ID}
+}
@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+)
+
+type EducationResponse struct {
+	ID           string        `json:"id"`
+	Institution  string        `json:"institution"`
+	Degree       string        `json:"degree,omitempty"`
+	FieldOfStudy string        `json:"fieldOfStudy,omitempty"`
+	Location     string        `json:"location,omitempty"`
+	StartDate    string        `json:"startDate"`         // "2006-01-02"
+	EndDate      *string       `json:"endDate,omitempty"` // nullable
+	IsCurrent    bool          `json:"isCurrent"`
+	Grade        string        `json:"grade,omitempty"`
+	Description  string        `json:"description,omitempty"`
+	SortOrder    int           `json:"sortOrder"`
+	Tags         []TagResponse `json:"tags"`
+}
+
+type EducationCreateRequest struct {
+	Institution  string   `json:"institution" validate:"required,max=255"`
+	Degree       string   `json:"degree" validate:"max=255"`
+	FieldOfStudy string   `json:"fieldOfStudy" validate:"max=255"`
+	Location     string   `json:"location" validate:"max=255"`
+	StartDate    string   `json:"startDate" validate:"required,datetime=2006-01-02"`
+	EndDate      *string  `json:"endDate" validate:"omitempty,datetime=2006-01-02"` // nullable
+	IsCurrent    bool     `json:"isCurrent"`
+	Grade        string   `json:"grade" validate:"max=100"`
+	Description  string   `json:"description"`
+	SortOrder    int      `json:"sortOrder"`
+	TagIDs       []string `json:"tagIds"` // UUID string
+}
+
+type EducationUpdateRequest = EducationCreateRequest
+
+func educationToResponse(e models.Education) EducationResponse {
+	tags := make([]TagResponse, 0, len(e.Tags))
+	for _, t := range e.Tags {
+		tags = append(tags, tagToResponse(t))
+	}
+
+	var endStr *string
+	if e.EndDate != nil {
+		s := e.EndDate.Format("2006-01-02")
+		endStr = &s
+	}
+
+	return EducationResponse{
+		ID:           e.ID.String(),
+		Institution:  e.Institution,
+		Degree:       e.Degree,
+		FieldOfStudy: e.FieldOfStudy,
+		Location:     e.Location,
+		StartDate:    e.StartDate.Format("2006-01-02"),
+		EndDate:      endStr,
+		IsCurrent:    e.IsCurrent,
+		Grade:        e.Grade,
+		Description:  e.Description,
+		SortOrder:    e.SortOrder,
+		Tags:         tags,
+	}
+}
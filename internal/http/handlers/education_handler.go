@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/changes"
+	"github.com/FauzanParanditha/portfolio-backend/internal/repository"
+	"github.com/gofiber/fiber/v2"
+	"github.com/rs/zerolog/log"
+)
+
+type EducationHandler struct {
+	repo repository.EducationRepository
+}
+
+func NewEducationHandler(repo repository.EducationRepository) *EducationHandler {
+	return &EducationHandler{repo: repo}
+}
+
+type EducationListResponse struct {
+	Data []EducationResponse `json:"data"`
+	Meta interface{}         `json:"meta"`
+}
+
+// GET /api/v1/education?tag=informatics
+// Public List Education godoc
+// @Summary      Get education
+// @Description  List education entries ordered by sortOrder, then most recent start date
+// @Tags         education
+// @Produce      json
+// @Param        tag  query  string  false  "Filter by tag name"
+// @Param        If-None-Match      header  string  false  "ETag from a previous response"
+// @Param        If-Modified-Since  header  string  false  "Last-Modified from a previous response"
+// @Success      200  {object}  EducationListResponse
+// @Success      304  "Not Modified"
+// @Failure      500  {object}  ErrorResponse
+// @Router       /education [get]
+func (h *EducationHandler) List(c *fiber.Ctx) error {
+	params := repository.EducationListParams{
+		Tag: strings.TrimSpace(c.Query("tag")),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	items, err := h.repo.ListPublic(ctx, params)
+	if err != nil {
+		log.Error().Err(err).Str("tag", params.Tag).Msg("failed to list education (public)")
+		return fiber.NewError(http.StatusInternalServerError, "failed to fetch education")
+	}
+
+	resp := make([]EducationResponse, 0, len(items))
+	updated := make([]time.Time, 0, len(items))
+	for _, e := range items {
+		resp = append(resp, educationToResponse(e))
+		updated = append(updated, e.UpdatedAt)
+	}
+
+	return sendConditionalJSON(c, fiber.Map{
+		"data": resp,
+		"meta": fiber.Map{
+			"tag":   params.Tag,
+			"total": len(resp),
+		},
+	}, lastModified(updated, changes.Education, changes.Tags))
+}
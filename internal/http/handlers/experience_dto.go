@@ -5,7 +5,14 @@ import (
 )
 
 type ExperienceHighlightResponse struct {
-	Text string `json:"text"`
+	ID        string `json:"id"`
+	Text      string `json:"text"`
+	SortOrder int    `json:"sortOrder"`
+}
+
+// Request admin untuk satu highlight (sub-resource)
+type ExperienceHighlightRequest struct {
+	Text string `json:"text" validate:"required"`
 }
 
 type ExperienceResponse struct {
@@ -14,29 +21,75 @@ type ExperienceResponse struct {
 	Company     string                       `json:"company"`
 	Location    string                       `json:"location,omitempty"`
 	StartDate   string                       `json:"startDate"`           // "2006-01-02"
-	EndDate     *string                      `json:"endDate,omitempty"`   // nullable
+	EndDate     *string                      `json:"endDate,omitempty"`   // selalu kosong kalau isCurrent
 	IsCurrent   bool                         `json:"isCurrent"`
 	Description string                       `json:"description,omitempty"`
 	SortOrder   int                          `json:"sortOrder"`
+
+	EmploymentType string `json:"employmentType,omitempty"`
+
+	Organization *OrganizationSummary `json:"organization"`
+
 	Tags        []TagResponse                `json:"tags"`
 	Highlights  []ExperienceHighlightResponse `json:"highlights"`
+	Projects    []ExperienceProjectSummary    `json:"projects"`
+}
+
+// Ringkasan project yang dikerjakan di experience ini
+type ExperienceProjectSummary struct {
+	ID            string `json:"id"`
+	Title         string `json:"title"`
+	Slug          string `json:"slug"`
+	ShortDesc     string `json:"shortDesc"`
+	CoverImageURL string `json:"coverImageUrl"`
 }
 
+// Aturan tanggal: isCurrent = true → endDate harus kosong; isCurrent = false →
+// endDate wajib dan tidak boleh sebelum startDate.
 type ExperienceCreateRequest struct {
-	Title       string   `json:"title" validate:"required"`
-	Company     string   `json:"company" validate:"required"`
-	Location    string   `json:"location"`
-	StartDate   string   `json:"startDate" validate:"required"` // "2006-01-02"
-	EndDate     *string  `json:"endDate"`                       // nullable
+	Title       string   `json:"title" validate:"required,max=255"`
+	Company     string   `json:"company" validate:"required_without=OrganizationID,max=255"` // diisi ulang dari nama organisasi kalau organizationId ada
+	OrganizationID *string `json:"organizationId" validate:"omitempty,uuid"`
+	Location    string   `json:"location" validate:"max=255"`
+	StartDate   string   `json:"startDate" validate:"required,datetime=2006-01-02"`
+	EndDate     *string  `json:"endDate" validate:"required_unless=IsCurrent true,empty_if=IsCurrent,omitempty,datetime=2006-01-02,date_gtefield=StartDate"`
 	IsCurrent   bool     `json:"isCurrent"`
+	// kosong = full_time
+	EmploymentType string `json:"employmentType" validate:"omitempty,oneof=full_time part_time contract freelance internship"`
 	Description string   `json:"description"`
 	SortOrder   int      `json:"sortOrder"`
 	TagIDs      []string `json:"tagIds"`      // UUID string
 	Highlights  []string `json:"highlights"`  // teks bullet
+	ProjectIDs  []string `json:"projectIds"`  // UUID project yang dikerjakan di sini
 }
 
 type ExperienceUpdateRequest = ExperienceCreateRequest
 
+// Peringatan (bukan error) dari admin create / update, mis. overlap dengan role full-time lain
+type ExperienceWarning struct {
+	Code         string `json:"code"` // "overlap"
+	Message      string `json:"message"`
+	ExperienceID string `json:"experienceId"`
+	Title        string `json:"title"`
+	Company      string `json:"company"`
+	StartDate    string `json:"startDate"`
+	EndDate      *string `json:"endDate,omitempty"`
+}
+
+// Response admin create / update
+type ExperienceWriteResponse struct {
+	Data     ExperienceResponse  `json:"data"`
+	Warnings []ExperienceWarning `json:"warnings"`
+}
+
+func highlightToResponse(h models.ExperienceHighlight) ExperienceHighlightResponse {
+	return ExperienceHighlightResponse{
+		ID:        h.ID.String(),
+		Text:      h.Text,
+		SortOrder: h.SortOrder,
+	}
+}
+
 func experienceToResponse(e models.Experience) ExperienceResponse {
 	tags := make([]TagResponse, 0, len(e.Tags))
 	for _, t := range e.Tags {
@@ -49,15 +102,25 @@ func experienceToResponse(e models.Experience) ExperienceResponse {
 
 	highs := make([]ExperienceHighlightResponse, 0, len(e.Highlights))
 	for _, h := range e.Highlights {
-		highs = append(highs, ExperienceHighlightResponse{
-			Text: h.Text,
+		highs = append(highs, highlightToResponse(h))
+	}
+
+	projects := make([]ExperienceProjectSummary, 0, len(e.Projects))
+	for _, p := range e.Projects {
+		projects = append(projects, ExperienceProjectSummary{
+			ID:            p.ID.String(),
+			Title:         p.Title,
+			Slug:          p.Slug,
+			ShortDesc:     p.ShortDesc,
+			CoverImageURL: p.CoverImageURL,
 		})
 	}
 
 	start := e.StartDate.Format("2006-01-02")
 
+	// role aktif tidak punya endDate, walau data lama sempat menyimpannya
 	var endStr *string
-	if e.EndDate != nil {
+	if e.EndDate != nil && !e.IsCurrent {
 		s := e.EndDate.Format("2006-01-02")
 		endStr = &s
 	}
@@ -72,8 +135,11 @@ func experienceToResponse(e models.Experience) ExperienceResponse {
 		IsCurrent:   e.IsCurrent,
 		Description: e.Description,
 		SortOrder:   e.SortOrder,
+		EmploymentType: e.EmploymentType,
+		Organization: organizationToSummary(e.Organization),
 		Tags:        tags,
 		Highlights:  highs,
+		Projects:    projects,
 	}
 }
 
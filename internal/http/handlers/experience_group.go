@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"strings"
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/FauzanParanditha/portfolio-backend/internal/tenure"
+)
+
+// Satu employer dengan role-role berurutan di dalamnya (?groupBy=company)
+type ExperienceCompanyGroup struct {
+	Company   string           `json:"company"`
+	StartDate string           `json:"startDate"`         // role paling awal
+	EndDate   *string          `json:"endDate,omitempty"` // kosong kalau masih ada role aktif
+	IsCurrent bool             `json:"isCurrent"`
+	Tenure    ExperienceTenure `json:"tenure"`
+	Roles     []any            `json:"roles"` // ExperienceResponse (ikut ?fields=)
+}
+
+// Masa kerja gabungan; bulan yang beririsan antar role hanya dihitung sekali
+type ExperienceTenure struct {
+	Years       int `json:"years"`
+	Months      int `json:"months"`
+	TotalMonths int `json:"totalMonths"`
+}
+
+// groupExperiencesByCompany: role yang bersebelahan (sesuai urutan list) dengan
+// company yang sama digabung jadi satu group. roles[i] = response untuk exps[i].
+func groupExperiencesByCompany(exps []models.Experience, roles []any, now time.Time) []ExperienceCompanyGroup {
+	groups := make([]ExperienceCompanyGroup, 0, len(exps))
+
+	start := 0
+	for i := 1; i <= len(exps); i++ {
+		if i < len(exps) && sameCompany(exps[i].Company, exps[start].Company) {
+			continue
+		}
+		groups = append(groups, companyGroup(exps[start:i], roles[start:i], now))
+		start = i
+	}
+
+	return groups
+}
+
+func sameCompany(a, b string) bool {
+	return strings.EqualFold(strings.TrimSpace(a), strings.TrimSpace(b))
+}
+
+func companyGroup(exps []models.Experience, roles []any, now time.Time) ExperienceCompanyGroup {
+	g := ExperienceCompanyGroup{
+		Company: strings.TrimSpace(exps[0].Company),
+		Roles:   append([]any(nil), roles...),
+	}
+
+	first := exps[0].StartDate
+	var last *time.Time
+	spans := make([]tenure.Span, 0, len(exps))
+
+	for _, e := range exps {
+		if e.StartDate.Before(first) {
+			first = e.StartDate
+		}
+
+		var end *time.Time
+		if e.IsCurrent {
+			g.IsCurrent = true
+		} else if e.EndDate != nil {
+			end = e.EndDate
+			if last == nil || e.EndDate.After(*last) {
+				last = e.EndDate
+			}
+		}
+
+		spans = append(spans, tenure.NewSpan(e.StartDate, end, now))
+	}
+
+	g.StartDate = first.Format("2006-01-02")
+	if !g.IsCurrent && last != nil {
+		s := last.Format("2006-01-02")
+		g.EndDate = &s
+	}
+
+	total := tenure.TotalMonths(spans)
+	g.Tenure = ExperienceTenure{
+		Years:       total / 12,
+		Months:      total % 12,
+		TotalMonths: total,
+	}
+
+	return g
+}
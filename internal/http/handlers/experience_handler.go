@@ -3,8 +3,13 @@ package handlers
 import (
 	"context"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/FauzanParanditha/portfolio-backend/internal/changes"
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/FauzanParanditha/portfolio-backend/internal/pagination"
 	"github.com/FauzanParanditha/portfolio-backend/internal/repository"
 	"github.com/gofiber/fiber/v2"
 	"github.com/rs/zerolog/log"
@@ -18,21 +23,124 @@ func NewExperienceHandler(repo repository.ExperienceRepository) *ExperienceHandl
 	return &ExperienceHandler{repo: repo}
 }
 
-// GET /api/v1/experiences
+type ExperiencesListResponse struct {
+	Data []ExperienceResponse `json:"data"`
+	Meta interface{}          `json:"meta"`
+}
+
+// GET /api/v1/experiences?tag=go&type=tech&year=2023&current=true&limit=10&groupBy=company
 // Public List Experiences godoc
 // @Summary      Get experiences
+// @Description  List experiences with tag / date filters and optional pagination (limit / cursor). groupBy=company returns data as company groups (company, startDate, endDate, isCurrent, tenure, roles) where consecutive roles at the same company are nested.
 // @Tags         experiences
 // @Accept       json
 // @Produce      json
-// @Success      200  {array}  ExperienceResponse
+// @Param        tag        query  string  false  "Filter by tag name"
+// @Param        type       query  string  false  "Filter by tag type, e.g. tech"
+// @Param        year       query  int     false  "Only roles active during this year"
+// @Param        from       query  string  false  "Only roles active on/after this date (YYYY, YYYY-MM or YYYY-MM-DD)"
+// @Param        to         query  string  false  "Only roles active on/before this date (YYYY, YYYY-MM or YYYY-MM-DD)"
+// @Param        current    query  bool    false  "Only current roles"
+// @Param        page       query  int     false  "Page number (requires limit)"
+// @Param        limit      query  int     false  "Items per page; empty = all experiences"
+// @Param        cursor     query  string  false  "Opaque cursor from meta.nextCursor / meta.prevCursor"
+// @Param        withTotal  query  bool    false  "Include total count (default false, paginated only)"
+// @Param        groupBy    query  string  false  "company"
+// @Param        fields     query  string  false  "Comma-separated response fields, e.g. title,company,startDate"
+// @Param        include    query  string  false  "Relations to load: highlights,tags,projects,organization"
+// @Param        If-None-Match      header  string  false  "ETag from a previous response"
+// @Param        If-Modified-Since  header  string  false  "Last-Modified from a previous response"
+// @Success      200  {object}  ExperiencesListResponse
+// @Success      304  "Not Modified"
+// @Failure      400  {object}  ErrorResponse
 // @Router       /experiences [get]
 func (h *ExperienceHandler) List(c *fiber.Ctx) error {
+	params := repository.ExperienceListParams{
+		Tag:         strings.TrimSpace(c.Query("tag")),
+		TagType:     strings.TrimSpace(c.Query("type")),
+		CurrentOnly: c.Query("current") == "true",
+		WithTotal:   withTotalQuery(c),
+	}
+
+	if y := c.Query("year"); y != "" {
+		year, err := strconv.Atoi(y)
+		if err != nil || year < 1900 || year > 9999 {
+			return fiber.NewError(http.StatusBadRequest, "invalid year")
+		}
+		from := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC)
+		params.From, params.To = &from, &to
+	}
+
+	// from / to menimpa year kalau dua-duanya dikirim
+	if s := c.Query("from"); s != "" {
+		from, err := parseDateBound(s, false)
+		if err != nil {
+			return fiber.NewError(http.StatusBadRequest, "invalid from date")
+		}
+		params.From = &from
+	}
+	if s := c.Query("to"); s != "" {
+		to, err := parseDateBound(s, true)
+		if err != nil {
+			return fiber.NewError(http.StatusBadRequest, "invalid to date")
+		}
+		params.To = &to
+	}
+	if params.From != nil && params.To != nil && params.To.Before(*params.From) {
+		return fiber.NewError(http.StatusBadRequest, "to must not be before from")
+	}
+
+	groupBy := c.Query("groupBy")
+	if groupBy != "" && groupBy != "company" {
+		return fiber.NewError(http.StatusBadRequest, "invalid groupBy: "+groupBy)
+	}
+
+	// pagination hanya kalau limit / cursor dikirim
+	list := cursorList(c, "experiences")
+	cursor, err := parseCursorQuery(c, list)
+	if err != nil {
+		return err
+	}
+	if c.Query("limit") != "" || cursor != nil {
+		page, err := strconv.Atoi(c.Query("page", "1"))
+		if err != nil || page < 1 {
+			page = 1
+		}
+		limit, err := strconv.Atoi(c.Query("limit", "10"))
+		if err != nil || limit <= 0 {
+			limit = 10
+		}
+		if limit > 50 {
+			limit = 50
+		}
+		params.Page, params.Limit, params.Cursor = page, limit, cursor
+	}
+
+	sparse, err := parseSparseQuery(c, experienceFieldSpec)
+	if err != nil {
+		return err
+	}
+	params.Projection = sparse.proj
+
+	// grouping butuh company & tanggal walau tidak diminta di ?fields=
+	if groupBy == "company" && params.Projection.Columns != nil {
+		params.Projection.Columns = append(params.Projection.Columns, "company", "end_date", "is_current")
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	exps, err := h.repo.ListPublic(ctx)
+	exps, info, err := h.repo.ListPublic(ctx, params)
 	if err != nil {
-		log.Error().Err(err).Msg("failed to list experiences (public)")
+		log.Error().
+			Err(err).
+			Str("tag", params.Tag).
+			Str("type", params.TagType).
+			Int("page", params.Page).
+			Int("limit", params.Limit).
+			Msg("failed to list experiences (public)")
+
 		return fiber.NewError(http.StatusInternalServerError, "failed to fetch experiences")
 	}
 
@@ -41,7 +149,66 @@ func (h *ExperienceHandler) List(c *fiber.Ctx) error {
 		resp = append(resp, experienceToResponse(e))
 	}
 
-	return c.JSON(fiber.Map{
-		"data": resp,
-	})
+	updated := make([]time.Time, 0, len(exps))
+	for _, e := range exps {
+		updated = append(updated, e.UpdatedAt)
+	}
+
+	meta := fiber.Map{
+		"tag":     params.Tag,
+		"type":    params.TagType,
+		"current": params.CurrentOnly,
+		"groupBy": groupBy,
+	}
+	if params.Limit > 0 {
+		next, prev := cursorLinks(list, exps, info, experienceCursor)
+		meta["page"] = params.Page
+		meta["limit"] = params.Limit
+		meta["hasMore"] = info.HasNext
+		meta["nextCursor"] = next
+		meta["prevCursor"] = prev
+		if info.Total != nil {
+			meta["total"] = *info.Total
+		}
+	}
+
+	var data any = sparseList(sparse, resp, experienceFieldSpec)
+	if groupBy == "company" {
+		roles := make([]any, 0, len(resp))
+		for _, r := range resp {
+			roles = append(roles, sparse.apply(r, experienceFieldSpec))
+		}
+		data = groupExperiencesByCompany(exps, roles, time.Now())
+	}
+
+	return sendConditionalJSON(c, fiber.Map{
+		"data": data,
+		"meta": meta,
+	}, lastModified(updated, changes.Experiences, changes.Tags))
+}
+
+// sort key experience public (sort_order ASC, start_date DESC, id DESC)
+func experienceCursor(e models.Experience) pagination.Cursor {
+	return pagination.Cursor{SortOrder: e.SortOrder, CreatedAt: e.StartDate, ID: e.ID}
+}
+
+// parseDateBound: "2023", "2023-06" atau "2023-06-15".
+// end = true → dibulatkan ke akhir tahun / bulan (untuk batas ?to=).
+func parseDateBound(s string, end bool) (time.Time, error) {
+	for _, layout := range []string{"2006-01-02", "2006-01", "2006"} {
+		t, err := time.Parse(layout, s)
+		if err != nil {
+			continue
+		}
+		if end {
+			switch layout {
+			case "2006-01":
+				t = t.AddDate(0, 1, -1)
+			case "2006":
+				t = t.AddDate(1, 0, -1)
+			}
+		}
+		return t, nil
+	}
+	return time.Time{}, fiber.NewError(http.StatusBadRequest, "invalid date")
 }
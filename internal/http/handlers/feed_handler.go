@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/changes"
+	"github.com/FauzanParanditha/portfolio-backend/internal/config"
+	"github.com/FauzanParanditha/portfolio-backend/internal/feed"
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/FauzanParanditha/portfolio-backend/internal/repository"
+	"github.com/gofiber/fiber/v2"
+	"github.com/rs/zerolog/log"
+)
+
+// feedStartedAt: pengganti waktu update untuk feed kosong, tetap selama proses hidup
+// supaya ETag / Last-Modified tidak berubah di setiap request
+var feedStartedAt = time.Now().UTC().Truncate(time.Second)
+
+// Feed di luar /api/v1 (dibaca feed reader, bukan frontend), jadi tidak masuk swagger
+type FeedHandler struct {
+	repo repository.ProjectRepository
+	cfg  *config.Config
+}
+
+func NewFeedHandler(repo repository.ProjectRepository, cfg *config.Config) *FeedHandler {
+	return &FeedHandler{repo: repo, cfg: cfg}
+}
+
+// GET /feeds/projects.rss?tag=go&limit=20
+func (h *FeedHandler) ProjectsRSS(c *fiber.Ctx) error {
+	return h.sendProjects(c, "projects.rss", feed.ContentTypeRSS, feed.Feed.RSS)
+}
+
+// GET /feeds/projects.atom?tag=go&limit=20
+func (h *FeedHandler) ProjectsAtom(c *fiber.Ctx) error {
+	return h.sendProjects(c, "projects.atom", feed.ContentTypeAtom, feed.Feed.Atom)
+}
+
+// GET /feeds/projects.json?tag=go&limit=20 (JSON Feed 1.1)
+func (h *FeedHandler) ProjectsJSON(c *fiber.Ctx) error {
+	return h.sendProjects(c, "projects.json", feed.ContentTypeJSON, feed.Feed.JSON)
+}
+
+func (h *FeedHandler) sendProjects(c *fiber.Ctx, file, contentType string, render func(feed.Feed) ([]byte, error)) error {
+	tag := strings.TrimSpace(c.Query("tag"))
+
+	limit, err := strconv.Atoi(c.Query("limit", "20"))
+	if err != nil || limit <= 0 {
+		limit = 20
+	}
+	if limit > 50 {
+		limit = 50
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	projects, err := h.repo.ListRecent(ctx, tag, limit)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("tag", tag).
+			Int("limit", limit).
+			Msg("failed to list projects for feed")
+
+		return fiber.NewError(http.StatusInternalServerError, "failed to fetch projects")
+	}
+
+	f := h.projectsFeed(projects, file, tag)
+
+	b, err := render(f)
+	if err != nil {
+		log.Error().Err(err).Str("feed", file).Msg("failed to render feed")
+		return fiber.NewError(http.StatusInternalServerError, "failed to render feed")
+	}
+
+	return sendConditional(c, b, contentType, f.Updated)
+}
+
+func (h *FeedHandler) projectsFeed(projects []models.Project, file, tag string) feed.Feed {
+	base := h.cfg.SiteBaseURL
+
+	title := h.cfg.SiteTitle + " - Projects"
+	feedURL := base + "/feeds/" + file
+	siteURL := base + "/projects"
+	if tag != "" {
+		title += " tagged " + tag
+		feedURL += "?tag=" + url.QueryEscape(tag)
+		siteURL += "?tag=" + url.QueryEscape(tag)
+	}
+
+	f := feed.Feed{
+		Title:       title,
+		Description: h.cfg.SiteDescription,
+		SiteURL:     siteURL,
+		FeedURL:     feedURL,
+		Language:    h.cfg.SiteLanguage,
+		Author:      h.cfg.SiteAuthor,
+		Items:       make([]feed.Item, 0, len(projects)),
+	}
+
+	updated := make([]time.Time, 0, len(projects))
+	for _, p := range projects {
+		link := base + "/projects/" + url.PathEscape(p.Slug)
+
+		categories := make([]string, 0, len(p.Tags))
+		for _, t := range p.Tags {
+			categories = append(categories, t.Name)
+		}
+
+		f.Items = append(f.Items, feed.Item{
+			// ID dari UUID, bukan URL: ganti slug tidak boleh bikin item "baru" di feed reader
+			ID:         "urn:uuid:" + p.ID.String(),
+			Title:      p.Title,
+			URL:        link,
+			Summary:    p.ShortDesc,
+			Content:    p.LongDesc,
+			ImageURL:   p.CoverImageURL,
+			Categories: categories,
+			Published:  p.CreatedAt,
+			Updated:    p.UpdatedAt,
+		})
+		updated = append(updated, p.UpdatedAt)
+	}
+
+	// tag ikut dihitung: rename tag mengubah category di feed
+	f.Updated = lastModified(updated, changes.Projects, changes.Tags)
+	if f.Updated.IsZero() {
+		// feed kosong & belum ada perubahan tercatat: jangan kirim tahun 0001
+		f.Updated = feedStartedAt
+	}
+
+	return f
+}
@@ -0,0 +1,38 @@
+package handlers
+
+import "github.com/FauzanParanditha/portfolio-backend/internal/models"
+
+type LinkHealthResponse struct {
+	ID           string `json:"id"`
+	ProjectID    string `json:"projectId"`
+	ProjectTitle string `json:"projectTitle,omitempty"`
+	ProjectSlug  string `json:"projectSlug,omitempty"`
+	Kind         string `json:"kind"`
+	URL          string `json:"url"`
+	StatusCode   int    `json:"statusCode"`
+	IsBroken     bool   `json:"isBroken"`
+	Error        string `json:"error,omitempty"`
+	LatencyMs    int64  `json:"latencyMs"`
+	CheckedAt    string `json:"checkedAt"`
+}
+
+func linkCheckToResponse(lc models.LinkCheck) LinkHealthResponse {
+	resp := LinkHealthResponse{
+		ID:         lc.ID.String(),
+		ProjectID:  lc.ProjectID.String(),
+		Kind:       lc.Kind,
+		URL:        lc.URL,
+		StatusCode: lc.StatusCode,
+		IsBroken:   lc.IsBroken,
+		Error:      lc.Error,
+		LatencyMs:  lc.LatencyMs,
+		CheckedAt:  lc.CheckedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if lc.Project != nil {
+		resp.ProjectTitle = lc.Project.Title
+		resp.ProjectSlug = lc.Project.Slug
+	}
+
+	return resp
+}
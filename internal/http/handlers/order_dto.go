@@ -0,0 +1,6 @@
+package handlers
+
+// Body untuk endpoint reorder: semua ID dalam urutan baru (index 0 = paling atas)
+type ReorderRequest struct {
+	IDs []string `json:"ids" validate:"required"`
+}
@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+)
+
+type OrganizationResponse struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Slug       string `json:"slug"`
+	LogoURL    string `json:"logoUrl,omitempty"`
+	WebsiteURL string `json:"websiteUrl,omitempty"`
+	Industry   string `json:"industry,omitempty"`
+	Location   string `json:"location,omitempty"`
+
+	// hanya di admin list / detail
+	ExperienceCount *int64 `json:"experienceCount,omitempty"`
+	ProjectCount    *int64 `json:"projectCount,omitempty"`
+}
+
+// Ringkasan organisasi yang ikut di response experience / project
+type OrganizationSummary struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Slug       string `json:"slug"`
+	LogoURL    string `json:"logoUrl,omitempty"`
+	WebsiteURL string `json:"websiteUrl,omitempty"`
+}
+
+type OrganizationCreateRequest struct {
+	Name       string `json:"name" validate:"required,max=255"`
+	Slug       string `json:"slug" validate:"max=255"` // kosong = dari name
+	LogoURL    string `json:"logoUrl" validate:"omitempty,url"`
+	WebsiteURL string `json:"websiteUrl" validate:"omitempty,url"`
+	Industry   string `json:"industry" validate:"max=255"`
+	Location   string `json:"location" validate:"max=255"`
+}
+
+type OrganizationUpdateRequest = OrganizationCreateRequest
+
+// Merge: semua experience & project milik sourceIds dipindah ke organisasi target,
+// lalu source dihapus
+type OrganizationMergeRequest struct {
+	SourceIDs []string `json:"sourceIds" validate:"required,min=1"`
+}
+
+type OrganizationMergeResponse struct {
+	Data               OrganizationResponse `json:"data"`
+	Merged             int                  `json:"merged"`             // jumlah organisasi source yang dihapus
+	ExperiencesUpdated int64                `json:"experiencesUpdated"` // experience yang dipindah
+	ProjectsUpdated    int64                `json:"projectsUpdated"`    // project yang dipindah
+}
+
+func organizationToResponse(o models.Organization) OrganizationResponse {
+	return OrganizationResponse{
+		ID:         o.ID.String(),
+		Name:       o.Name,
+		Slug:       o.Slug,
+		LogoURL:    o.LogoURL,
+		WebsiteURL: o.WebsiteURL,
+		Industry:   o.Industry,
+		Location:   o.Location,
+	}
+}
+
+// nil kalau belum di-link / tidak di-preload
+func organizationToSummary(o *models.Organization) *OrganizationSummary {
+	if o == nil {
+		return nil
+	}
+	return &OrganizationSummary{
+		ID:         o.ID.String(),
+		Name:       o.Name,
+		Slug:       o.Slug,
+		LogoURL:    o.LogoURL,
+		WebsiteURL: o.WebsiteURL,
+	}
+}
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/pagination"
+	"github.com/gofiber/fiber/v2"
+)
+
+// query param yang tidak mengubah isi / urutan list
+var cursorIgnoredParams = map[string]bool{
+	"cursor": true, "page": true, "limit": true, "withTotal": true, "fields": true, "include": true,
+}
+
+// Helper: identitas list untuk cursor = resource + hash filter di query string.
+// Cursor dari list lain (resource atau filter beda) ditolak saat decode.
+func cursorList(c *fiber.Ctx, resource string) string {
+	var params []string
+	c.Context().QueryArgs().VisitAll(func(k, v []byte) {
+		if !cursorIgnoredParams[string(k)] {
+			params = append(params, string(k)+"="+string(v))
+		}
+	})
+	if len(params) == 0 {
+		return resource
+	}
+
+	sort.Strings(params)
+	sum := sha256.Sum256([]byte(strings.Join(params, "&")))
+	return resource + ":" + hex.EncodeToString(sum[:8])
+}
+
+// Helper: ambil ?cursor= (opsional). Cursor rusak / tanda tangan tidak cocok /
+// dari list lain → 400.
+func parseCursorQuery(c *fiber.Ctx, list string) (*pagination.Cursor, error) {
+	raw := c.Query("cursor")
+	if raw == "" {
+		return nil, nil
+	}
+
+	cur, err := pagination.Decode(list, raw)
+	if err != nil {
+		return nil, fiber.NewError(http.StatusBadRequest, "invalid cursor")
+	}
+
+	return cur, nil
+}
+
+// Helper: ?withTotal=true — total opt-in, tanpa itu COUNT(*) dilewati
+func withTotalQuery(c *fiber.Ctx) bool {
+	return c.Query("withTotal") == "true"
+}
+
+// Helper: nextCursor/prevCursor dari item terakhir/pertama di halaman
+func cursorLinks[T any](list string, items []T, info pagination.PageInfo, key func(T) pagination.Cursor) (next, prev *string) {
+	if len(items) == 0 {
+		return nil, nil
+	}
+
+	return pagination.Links(list, info, key(items[0]), key(items[len(items)-1]))
+}
@@ -0,0 +1,214 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/cache"
+	"github.com/FauzanParanditha/portfolio-backend/internal/changes"
+	"github.com/FauzanParanditha/portfolio-backend/internal/config"
+	"github.com/FauzanParanditha/portfolio-backend/internal/jsonresume"
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/FauzanParanditha/portfolio-backend/internal/pdf"
+	"github.com/FauzanParanditha/portfolio-backend/internal/repository"
+	"github.com/FauzanParanditha/portfolio-backend/internal/skills"
+	"github.com/gofiber/fiber/v2"
+	"github.com/rs/zerolog/log"
+)
+
+// batas waktu render case study (termasuk fetch screenshot)
+const caseStudyTimeout = 30 * time.Second
+
+type PDFHandler struct {
+	exps     repository.ExperienceRepository
+	projects repository.ProjectRepository
+	skills   repository.SkillRepository
+	cfg      *config.Config
+
+	// hasil render; key memuat waktu modified, jadi entry lama tidak terpakai lagi
+	// begitu konten berubah (dan akhirnya keluar lewat LRU / TTL)
+	cache *cache.Cache
+	// nil = screenshot ditulis sebagai URL
+	images pdf.ImageLoader
+}
+
+func NewPDFHandler(exps repository.ExperienceRepository, projects repository.ProjectRepository, skillRepo repository.SkillRepository, cfg *config.Config) *PDFHandler {
+	h := &PDFHandler{
+		exps:     exps,
+		projects: projects,
+		skills:   skillRepo,
+		cfg:      cfg,
+		cache: cache.New(cache.Config{
+			TTL:        time.Duration(cfg.PDFCacheTTL) * time.Second,
+			MaxEntries: cfg.PDFCacheMaxEntries,
+		}),
+	}
+
+	if cfg.PDFFetchImages {
+		h.images = pdf.HTTPImageLoader(pdf.NewImageClient(5*time.Second), int64(cfg.PDFImageMaxBytes))
+	}
+
+	return h
+}
+
+// GET /api/v1/resume.pdf?template=compact&paper=letter
+// Resume PDF godoc
+// @Summary      Download resume as PDF
+// @Description  CV rendered server-side from profile config, experiences (with highlights) and the skills matrix. Cached until experiences, tags or skill overrides change.
+// @Tags         resume
+// @Produce      application/pdf
+// @Param        template  query  string  false  "classic, compact or serif (default from PDF_RESUME_TEMPLATE)"
+// @Param        paper     query  string  false  "A4, Letter or Legal (default from PDF_PAPER_SIZE)"
+// @Param        If-None-Match      header  string  false  "ETag from a previous response"
+// @Param        If-Modified-Since  header  string  false  "Last-Modified from a previous response"
+// @Success      200  {file}    file
+// @Success      304  "Not Modified"
+// @Failure      400  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /resume.pdf [get]
+func (h *PDFHandler) Resume(c *fiber.Ctx) error {
+	opt, err := h.options(c, h.cfg.PDFResumeTemplate)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	exps, _, err := h.exps.ListPublic(ctx, repository.ExperienceListParams{})
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list experiences for resume pdf")
+		return fiber.NewError(http.StatusInternalServerError, "failed to build resume")
+	}
+
+	src, err := loadSkillSources(ctx, h.skills)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to load skills for resume pdf")
+		return fiber.NewError(http.StatusInternalServerError, "failed to build resume")
+	}
+
+	updated := make([]time.Time, 0, len(exps)+len(src.Overrides))
+	for _, e := range exps {
+		updated = append(updated, e.UpdatedAt)
+	}
+	for _, o := range src.Overrides {
+		updated = append(updated, o.UpdatedAt)
+	}
+	modified := lastModified(updated, changes.Experiences, changes.Tags, changes.Skills)
+
+	basics := resumeBasics(h.cfg)
+	opt.Title = strings.TrimSpace(basics.Name + " - Resume")
+	opt.Author = basics.Name
+	opt.Modified = modified
+
+	key := pdfCacheKey("resume", opt, "")
+	b, err := cache.Fetch(h.cache, key, func() ([]byte, error) {
+		// format tanggal & highlight sama dengan resume.json
+		doc := jsonresume.Build(basics, exps, nil, nil, nil, modified)
+		return pdf.Resume(opt, basics, doc.Work, skills.Build(src, time.Now()))
+	})
+	if err != nil {
+		log.Error().Err(err).Str("template", opt.Template.Name).Msg("failed to render resume pdf")
+		return fiber.NewError(http.StatusInternalServerError, "failed to render resume")
+	}
+
+	c.Set(fiber.HeaderContentDisposition, `inline; filename="resume.pdf"`)
+	return sendConditional(c, b, pdf.ContentType, modified)
+}
+
+// GET /api/v1/projects/:slug/case-study.pdf?template=serif&paper=a4
+// Project Case Study PDF godoc
+// @Summary      Download project case study as PDF
+// @Description  Printable case study from the project's description, challenge, solution, results, features, technical details and screenshots. Screenshots that cannot be fetched are listed by URL.
+// @Tags         projects
+// @Produce      application/pdf
+// @Param        slug      path   string  true   "Project slug"
+// @Param        template  query  string  false  "classic, compact or serif (default from PDF_CASE_STUDY_TEMPLATE)"
+// @Param        paper     query  string  false  "A4, Letter or Legal (default from PDF_PAPER_SIZE)"
+// @Param        If-None-Match      header  string  false  "ETag from a previous response"
+// @Param        If-Modified-Since  header  string  false  "Last-Modified from a previous response"
+// @Success      200  {file}    file
+// @Success      304  "Not Modified"
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /projects/{slug}/case-study.pdf [get]
+func (h *PDFHandler) CaseStudy(c *fiber.Ctx) error {
+	slug := c.Params("slug")
+
+	opt, err := h.options(c, h.cfg.PDFCaseStudyTemplate)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), caseStudyTimeout)
+	defer cancel()
+
+	project, err := h.projects.GetBySlug(ctx, slug, repository.Projection{})
+	if err != nil {
+		if err.Error() == "record not found" {
+			return fiber.NewError(http.StatusNotFound, "project not found")
+		}
+
+		log.Error().
+			Err(err).
+			Str("slug", slug).
+			Msg("failed to get project for case study pdf")
+
+		return fiber.NewError(http.StatusInternalServerError, "failed to fetch project")
+	}
+
+	modified := lastModified([]time.Time{project.UpdatedAt}, changes.Projects, changes.Tags)
+
+	opt.Title = project.Title + " - Case Study"
+	opt.Author = h.cfg.SiteAuthor
+	opt.Modified = modified
+
+	key := pdfCacheKey("case-study", opt, project.Slug)
+	b, err := cache.Fetch(h.cache, key, func() ([]byte, error) {
+		return pdf.CaseStudy(ctx, opt, *project, h.projectURL(*project), h.images)
+	})
+	if err != nil {
+		log.Error().Err(err).Str("slug", slug).Str("template", opt.Template.Name).Msg("failed to render case study pdf")
+		return fiber.NewError(http.StatusInternalServerError, "failed to render case study")
+	}
+
+	c.Set(fiber.HeaderContentDisposition, `inline; filename="`+project.Slug+`-case-study.pdf"`)
+	return sendConditional(c, b, pdf.ContentType, modified)
+}
+
+// options: template & paper dari query, default dari config
+func (h *PDFHandler) options(c *fiber.Ctx, defaultTemplate string) (pdf.Options, error) {
+	name := c.Query("template", defaultTemplate)
+	tpl, ok := pdf.LookupTemplate(name)
+	if !ok {
+		return pdf.Options{}, fiber.NewError(http.StatusBadRequest, "invalid template: "+name+" (allowed: "+strings.Join(pdf.TemplateNames(), ", ")+")")
+	}
+
+	size := c.Query("paper", h.cfg.PDFPaperSize)
+	paper, ok := pdf.NormalizePaper(size)
+	if !ok {
+		return pdf.Options{}, fiber.NewError(http.StatusBadRequest, "invalid paper: "+size+" (allowed: A4, Letter, Legal)")
+	}
+
+	return pdf.Options{Template: tpl, Paper: paper}, nil
+}
+
+func (h *PDFHandler) projectURL(p models.Project) string {
+	return h.cfg.SiteBaseURL + "/projects/" + url.PathEscape(p.Slug)
+}
+
+// pdfCacheKey: kind|template|paper|slug|modified
+func pdfCacheKey(kind string, opt pdf.Options, slug string) string {
+	return strings.Join([]string{
+		kind,
+		opt.Template.Name,
+		opt.Paper,
+		slug,
+		strconv.FormatInt(opt.Modified.Unix(), 10),
+	}, "|")
+}
@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/FauzanParanditha/portfolio-backend/internal/pagination"
+	"github.com/FauzanParanditha/portfolio-backend/internal/seo"
+)
+
+type PostCreateRequest struct {
+	Title         string `json:"title" validate:"required"`
+	Slug          string `json:"slug" validate:"required"`
+	Excerpt       string `json:"excerpt"`
+	Body          string `json:"body" validate:"required"` // markdown
+	CoverImageURL string `json:"coverImageUrl" validate:"omitempty,url"`
+
+	Status      string     `json:"status" validate:"required,oneof=draft published"`
+	PublishedAt *time.Time `json:"publishedAt"` // kosong + published → sekarang
+
+	TagIDs []string `json:"tagIds"`
+
+	Seo *SEOFields `json:"seo"` // null = semua pakai fallback
+}
+
+type PostUpdateRequest = PostCreateRequest
+
+type PostResponse struct {
+	ID            string `json:"id"`
+	Title         string `json:"title"`
+	Slug          string `json:"slug"`
+	Excerpt       string `json:"excerpt"`
+	Body          string `json:"body,omitempty"` // tidak dikirim di list
+	CoverImageURL string `json:"coverImageUrl"`
+
+	Status      string  `json:"status"`
+	PublishedAt *string `json:"publishedAt"`
+	ReadingTime int     `json:"readingTime"` // menit
+
+	Tags []TagResponse `json:"tags"`
+
+	Seo SEOFields `json:"seo"`
+	// meta tag final + JSON-LD, hanya di detail public
+	Head *seo.Head `json:"head,omitempty"`
+
+	CreatedAt string `json:"createdAt"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+type PostsListResponse struct {
+	Data []PostResponse `json:"data"`
+	Meta interface{}    `json:"meta"`
+}
+
+// Mapper dari models.Post ke PostResponse. withBody = false untuk list.
+func postToResponse(p models.Post, withBody bool) PostResponse {
+	tags := make([]TagResponse, 0, len(p.Tags))
+	for _, t := range p.Tags {
+		tags = append(tags, tagToResponse(t))
+	}
+
+	var publishedAt *string
+	if p.PublishedAt != nil {
+		s := p.PublishedAt.Format(time.RFC3339)
+		publishedAt = &s
+	}
+
+	resp := PostResponse{
+		ID:            p.ID.String(),
+		Title:         p.Title,
+		Slug:          p.Slug,
+		Excerpt:       p.Excerpt,
+		CoverImageURL: p.CoverImageURL,
+
+		Status:      p.Status,
+		PublishedAt: publishedAt,
+		ReadingTime: p.ReadingTime,
+
+		Tags: tags,
+
+		Seo: seoToResponse(p.SEO),
+
+		CreatedAt: p.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: p.UpdatedAt.Format(time.RFC3339),
+	}
+
+	if withBody {
+		resp.Body = p.Body
+	}
+
+	return resp
+}
+
+// sort key post public (published_at DESC, id DESC) untuk cursor pagination
+func postCursor(p models.Post) pagination.Cursor {
+	c := pagination.Cursor{ID: p.ID}
+	if p.PublishedAt != nil {
+		c.CreatedAt = *p.PublishedAt
+	}
+	return c
+}
+
+// sort key post admin (created_at DESC, id DESC)
+func adminPostCursor(p models.Post) pagination.Cursor {
+	return pagination.Cursor{CreatedAt: p.CreatedAt, ID: p.ID}
+}
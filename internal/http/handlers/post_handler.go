@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/changes"
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/FauzanParanditha/portfolio-backend/internal/repository"
+	"github.com/FauzanParanditha/portfolio-backend/internal/seo"
+	"github.com/gofiber/fiber/v2"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+type PostHandler struct {
+	repo repository.PostRepository
+	site seo.Site
+}
+
+func NewPostHandler(repo repository.PostRepository, site seo.Site) *PostHandler {
+	return &PostHandler{repo: repo, site: site}
+}
+
+// GET /api/v1/posts?q=...&tag=go&page=1&limit=10&cursor=...&withTotal=true
+// List Public Posts godoc
+// @Summary      Get published posts
+// @Description  List published posts (newest first) with search, tag filter & pagination (page or cursor)
+// @Tags         posts
+// @Accept       json
+// @Produce      json
+// @Param        q          query    string false "Search keyword"
+// @Param        tag        query    string false "Filter by tag name"
+// @Param        page       query    int    false "Page number"
+// @Param        limit      query    int    false "Items per page"
+// @Param        cursor     query    string false "Opaque cursor from meta.nextCursor / meta.prevCursor"
+// @Param        withTotal  query    bool   false "Include total count (default false)"
+// @Param        If-None-Match      header  string false "ETag from a previous response"
+// @Param        If-Modified-Since  header  string false "Last-Modified from a previous response"
+// @Success      200  {object}  PostsListResponse
+// @Success      304  "Not Modified"
+// @Failure      400  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /posts [get]
+func (h *PostHandler) List(c *fiber.Ctx) error {
+	searchQ := c.Query("q")
+	tag := c.Query("tag")
+
+	page, err := strconv.Atoi(c.Query("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	limit, err := strconv.Atoi(c.Query("limit", "10"))
+	if err != nil || limit <= 0 {
+		limit = 10
+	}
+	if limit > 50 {
+		limit = 50
+	}
+
+	list := cursorList(c, "posts")
+	cursor, err := parseCursorQuery(c, list)
+	if err != nil {
+		return err
+	}
+	withTotal := withTotalQuery(c)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	posts, info, err := h.repo.ListPublic(ctx, repository.PostListParams{
+		Query:     searchQ,
+		Tag:       tag,
+		Page:      page,
+		Limit:     limit,
+		Cursor:    cursor,
+		WithTotal: withTotal,
+	})
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("q", searchQ).
+			Str("tag", tag).
+			Int("page", page).
+			Int("limit", limit).
+			Msg("failed to list posts (public)")
+
+		return fiber.NewError(http.StatusInternalServerError, "failed to fetch posts")
+	}
+
+	resp := make([]PostResponse, 0, len(posts))
+	updated := make([]time.Time, 0, len(posts))
+	for _, p := range posts {
+		resp = append(resp, postToResponse(p, false))
+		updated = append(updated, postModified(p))
+	}
+
+	next, prev := cursorLinks(list, posts, info, postCursor)
+
+	meta := fiber.Map{
+		"page":       page,
+		"limit":      limit,
+		"hasMore":    info.HasNext,
+		"nextCursor": next,
+		"prevCursor": prev,
+		"q":          searchQ,
+		"tag":        tag,
+	}
+	if info.Total != nil {
+		meta["total"] = *info.Total
+	}
+
+	return sendConditionalJSON(c, fiber.Map{
+		"data": resp,
+		"meta": meta,
+	}, lastModified(updated, changes.Posts, changes.Tags))
+}
+
+// GET /api/v1/posts/:slug
+// Get Post By Slug godoc
+// @Summary      Get post detail
+// @Description  Get a single published post (markdown body) by slug, with resolved SEO meta + JSON-LD (head)
+// @Tags         posts
+// @Accept       json
+// @Produce      json
+// @Param        slug  path    string  true  "Post slug"
+// @Param        If-None-Match      header  string  false  "ETag from a previous response"
+// @Param        If-Modified-Since  header  string  false  "Last-Modified from a previous response"
+// @Success      200   {object}  PostResponse
+// @Success      304   "Not Modified"
+// @Failure      404   {object}  ErrorResponse
+// @Router       /posts/{slug} [get]
+func (h *PostHandler) DetailBySlug(c *fiber.Ctx) error {
+	slug := c.Params("slug")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	post, err := h.repo.GetBySlug(ctx, slug)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return fiber.NewError(http.StatusNotFound, "post not found")
+		}
+
+		log.Error().
+			Err(err).
+			Str("slug", slug).
+			Msg("failed to get post by slug (public)")
+
+		return fiber.NewError(http.StatusInternalServerError, "failed to fetch post")
+	}
+
+	resp := postToResponse(*post, true)
+	head := seo.PostHead(h.site, *post)
+	resp.Head = &head
+
+	return sendConditionalJSON(c, fiber.Map{
+		"data": resp,
+	}, lastModified([]time.Time{postModified(*post)}, changes.Posts, changes.Tags))
+}
+
+// postModified: post terjadwal "berubah" saat published_at lewat, bukan saat disimpan
+func postModified(p models.Post) time.Time {
+	if p.PublishedAt != nil && p.PublishedAt.After(p.UpdatedAt) {
+		return *p.PublishedAt
+	}
+	return p.UpdatedAt
+}
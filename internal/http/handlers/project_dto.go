@@ -2,6 +2,8 @@ package handlers
 
 import (
 	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/FauzanParanditha/portfolio-backend/internal/pagination"
+	"github.com/FauzanParanditha/portfolio-backend/internal/seo"
 )
 
 // Request body untuk create/update project (dipakai AdminProjectHandler)
@@ -27,23 +29,42 @@ type ProjectCreateRequest struct {
 
 	Screenshots []string `json:"screenshots"` // list URL gambar, sederhana dulu
 
+	Seo *SEOFields `json:"seo"` // null = semua pakai fallback
+
 	IsFeatured bool     `json:"isFeatured"`
 	SortOrder  int      `json:"sortOrder"`
 	TagIDs     []string `json:"tagIds"`   // list UUID string
 	Features   []string `json:"features"` // list text bullet
+
+	ExperienceIDs []string `json:"experienceIds"` // UUID experience tempat project dikerjakan
+
+	ClientID *string `json:"clientId" validate:"omitempty,uuid"` // organisasi client, null = tanpa client
 }
 
 // Response kecil untuk feature
 type ProjectFeatureResponse struct {
-	Text string `json:"text"`
+	ID        string `json:"id"`
+	Text      string `json:"text"`
+	SortOrder int    `json:"sortOrder"`
 }
 
 // Response kecil untuk screenshot
 type ProjectScreenshotResponse struct {
+	ID        string `json:"id"`
 	ImageURL  string `json:"imageUrl"`
 	SortOrder int    `json:"sortOrder"`
 }
 
+// Request admin untuk satu feature (sub-resource)
+type ProjectFeatureRequest struct {
+	Text string `json:"text" validate:"required"`
+}
+
+// Request admin untuk satu screenshot (sub-resource)
+type ProjectScreenshotRequest struct {
+	ImageURL string `json:"imageUrl" validate:"required"`
+}
+
 // Response utama untuk Project (public + admin)
 type ProjectResponse struct {
 	ID            string `json:"id"`
@@ -72,6 +93,60 @@ type ProjectResponse struct {
 	Tags        []TagResponse               `json:"tags"`
 	Features    []ProjectFeatureResponse    `json:"features"`
 	Screenshots []ProjectScreenshotResponse `json:"screenshots"`
+	Experiences []ProjectExperienceSummary  `json:"experiences"`
+	Client      *OrganizationSummary        `json:"client"`
+
+	Seo SEOFields `json:"seo"`
+	// meta tag final + JSON-LD, hanya di detail public (tanpa ?fields=)
+	Head *seo.Head `json:"head,omitempty"`
+
+	// hanya diisi di detail public kalau ?nav=true
+	Prev *ProjectNavItem `json:"prev,omitempty"`
+	Next *ProjectNavItem `json:"next,omitempty"`
+}
+
+// Experience (company & role) tempat project dikerjakan
+type ProjectExperienceSummary struct {
+	ID        string  `json:"id"`
+	Company   string  `json:"company"`
+	Title     string  `json:"title"` // role
+	StartDate string  `json:"startDate"`
+	EndDate   *string `json:"endDate,omitempty"`
+	IsCurrent bool    `json:"isCurrent"`
+}
+
+// Ringkasan kecil untuk navigasi previous/next case study
+type ProjectNavItem struct {
+	Slug          string `json:"slug"`
+	Title         string `json:"title"`
+	CoverImageURL string `json:"coverImageUrl"`
+}
+
+func projectToNavItem(p *models.Project) *ProjectNavItem {
+	if p == nil {
+		return nil
+	}
+	return &ProjectNavItem{
+		Slug:          p.Slug,
+		Title:         p.Title,
+		CoverImageURL: p.CoverImageURL,
+	}
+}
+
+func featureToResponse(f models.ProjectFeature) ProjectFeatureResponse {
+	return ProjectFeatureResponse{
+		ID:        f.ID.String(),
+		Text:      f.Text,
+		SortOrder: f.SortOrder,
+	}
+}
+
+func screenshotToResponse(s models.ProjectScreenshot) ProjectScreenshotResponse {
+	return ProjectScreenshotResponse{
+		ID:        s.ID.String(),
+		ImageURL:  s.ImageURL,
+		SortOrder: s.SortOrder,
+	}
 }
 
 // Mapper dari models.Project ke ProjectResponse
@@ -87,16 +162,24 @@ func projectToResponse(p models.Project) ProjectResponse {
 
 	features := make([]ProjectFeatureResponse, 0, len(p.Features))
 	for _, f := range p.Features {
-		features = append(features, ProjectFeatureResponse{
-			Text: f.Text,
-		})
+		features = append(features, featureToResponse(f))
 	}
 
 	screenshots := make([]ProjectScreenshotResponse, 0, len(p.Screenshots))
 	for _, s := range p.Screenshots {
-		screenshots = append(screenshots, ProjectScreenshotResponse{
-			ImageURL:  s.ImageURL,
-			SortOrder: s.SortOrder,
+		screenshots = append(screenshots, screenshotToResponse(s))
+	}
+
+	experiences := make([]ProjectExperienceSummary, 0, len(p.Experiences))
+	for _, e := range p.Experiences {
+		r := experienceToResponse(e)
+		experiences = append(experiences, ProjectExperienceSummary{
+			ID:        r.ID,
+			Company:   r.Company,
+			Title:     r.Title,
+			StartDate: r.StartDate,
+			EndDate:   r.EndDate,
+			IsCurrent: r.IsCurrent,
 		})
 	}
 
@@ -131,9 +214,18 @@ func projectToResponse(p models.Project) ProjectResponse {
 		Tags:        tags,
 		Features:    features,
 		Screenshots: screenshots,
+		Experiences: experiences,
+		Client:      organizationToSummary(p.Client),
+
+		Seo: seoToResponse(p.SEO),
 	}
 }
 
+// sort key project untuk cursor pagination
+func projectCursor(p models.Project) pagination.Cursor {
+	return pagination.Cursor{SortOrder: p.SortOrder, CreatedAt: p.CreatedAt, ID: p.ID}
+}
+
 type ProjectsListResponse struct {
 	Data []ProjectResponse `json:"data"`
 	Meta interface{}       `json:"meta"`
@@ -6,31 +6,46 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/FauzanParanditha/portfolio-backend/internal/analytics"
+	"github.com/FauzanParanditha/portfolio-backend/internal/changes"
 	"github.com/FauzanParanditha/portfolio-backend/internal/repository"
+	"github.com/FauzanParanditha/portfolio-backend/internal/seo"
 	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
 )
 
 type ProjectHandler struct {
-	repo repository.ProjectRepository
+	repo  repository.ProjectRepository
+	views *analytics.Recorder // boleh nil kalau analytics dimatikan
+	site  seo.Site
 }
 
-func NewProjectHandler(repo repository.ProjectRepository) *ProjectHandler {
-	return &ProjectHandler{repo: repo}
+func NewProjectHandler(repo repository.ProjectRepository, views *analytics.Recorder, site seo.Site) *ProjectHandler {
+	return &ProjectHandler{repo: repo, views: views, site: site}
 }
 
-// GET /api/v1/projects?featured=true&q=...&page=1&limit=12
+// GET /api/v1/projects?featured=true&q=...&page=1&limit=12&cursor=...&withTotal=true
 // List Public Projects godoc
 // @Summary      Get public projects
-// @Description  List projects visible publicly with search & pagination
+// @Description  List projects visible publicly with search & pagination (page or cursor)
 // @Tags         projects
 // @Accept       json
 // @Produce      json
-// @Param        q         query    string false "Search keyword"
-// @Param        featured  query    bool   false "Filter featured"
-// @Param        page      query    int    false "Page number"
-// @Param        limit     query    int    false "Items per page"
+// @Param        q          query    string false "Search keyword"
+// @Param        featured   query    bool   false "Filter featured"
+// @Param        experience query    string false "Only projects linked to this experience ID"
+// @Param        page       query    int    false "Page number"
+// @Param        limit      query    int    false "Items per page"
+// @Param        cursor     query    string false "Opaque cursor from meta.nextCursor / meta.prevCursor"
+// @Param        withTotal  query    bool   false "Include total count (default false)"
+// @Param        fields     query    string false "Comma-separated response fields, e.g. title,slug,coverImageUrl,tags"
+// @Param        include    query    string false "Relations to load: tags,features,screenshots,experiences,client"
+// @Param        If-None-Match      header  string false "ETag from a previous response"
+// @Param        If-Modified-Since  header  string false "Last-Modified from a previous response"
 // @Success      200  {object}  ProjectsListResponse
+// @Success      304  "Not Modified"
+// @Failure      400  {object}  ErrorResponse
 // @Failure      500  {object}  ErrorResponse
 // @Router       /projects [get]
 func (h *ProjectHandler) List(c *fiber.Ctx) error {
@@ -49,17 +64,43 @@ func (h *ProjectHandler) List(c *fiber.Ctx) error {
 		limit = 50
 	}
 
+	list := cursorList(c, "projects")
+	cursor, err := parseCursorQuery(c, list)
+	if err != nil {
+		return err
+	}
+	withTotal := withTotalQuery(c)
+
+	// ?experience=<uuid> → project yang dikerjakan di experience tsb
+	var experienceID *uuid.UUID
+	if v := c.Query("experience"); v != "" {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			return fiber.NewError(http.StatusBadRequest, "invalid experience")
+		}
+		experienceID = &id
+	}
+
+	sparse, err := parseSparseQuery(c, projectFieldSpec)
+	if err != nil {
+		return err
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
 	params := repository.ProjectListParams{
 		FeaturedOnly: featured,
+		ExperienceID: experienceID,
 		Query:        searchQ,
 		Page:         page,
 		Limit:        limit,
+		Cursor:       cursor,
+		WithTotal:    withTotal,
+		Projection:   sparse.proj,
 	}
 
-	projects, total, err := h.repo.ListPublic(ctx, params)
+	projects, info, err := h.repo.ListPublic(ctx, params)
 	if err != nil {
 		log.Error().
 			Err(err).
@@ -77,39 +118,66 @@ func (h *ProjectHandler) List(c *fiber.Ctx) error {
 		resp = append(resp, projectToResponse(p))
 	}
 
-	hasMore := int64(page*limit) < total
+	next, prev := cursorLinks(list, projects, info, projectCursor)
+
+	meta := fiber.Map{
+		"page":       page,
+		"limit":      limit,
+		"hasMore":    info.HasNext,
+		"nextCursor": next,
+		"prevCursor": prev,
+		"q":          searchQ,
+		"featured":   featured,
+	}
+	if experienceID != nil {
+		meta["experience"] = experienceID.String()
+	}
+	if info.Total != nil {
+		meta["total"] = *info.Total
+	}
+
+	updated := make([]time.Time, 0, len(projects))
+	for _, p := range projects {
+		updated = append(updated, p.UpdatedAt)
+	}
 
-	return c.JSON(fiber.Map{
-		"data": resp,
-		"meta": fiber.Map{
-			"page":     page,
-			"limit":    limit,
-			"total":    total,
-			"hasMore":  hasMore,
-			"q":        searchQ,
-			"featured": featured,
-		},
-	})
+	return sendConditionalJSON(c, fiber.Map{
+		"data": sparseList(sparse, resp, projectFieldSpec),
+		"meta": meta,
+	}, lastModified(updated, changes.Projects, changes.Tags))
 }
 
-// GET /api/v1/projects/:slug
+// GET /api/v1/projects/:slug?nav=true&sameCategory=true
 // Get Project By Slug godoc
 // @Summary      Get project detail
-// @Description  Get single public project by slug
+// @Description  Get single public project by slug, optionally with prev/next navigation. Includes resolved SEO meta + JSON-LD (head) when fields/include are not used.
 // @Tags         projects
 // @Accept       json
 // @Produce      json
-// @Param        slug   path  string  true  "Project slug"
+// @Param        slug          path   string  true   "Project slug"
+// @Param        nav           query  bool    false  "Include prev/next summaries"
+// @Param        sameCategory  query  bool    false  "Limit prev/next to the same category"
+// @Param        fields        query  string  false  "Comma-separated response fields"
+// @Param        include       query  string  false  "Relations to load: tags,features,screenshots,experiences,client"
+// @Param        If-None-Match      header  string  false  "ETag from a previous response"
+// @Param        If-Modified-Since  header  string  false  "Last-Modified from a previous response"
 // @Success      200    {object}  ProjectResponse
+// @Success      304    "Not Modified"
+// @Failure      400    {object}  ErrorResponse
 // @Failure      404    {object}  ErrorResponse
 // @Router       /projects/{slug} [get]
 func (h *ProjectHandler) DetailBySlug(c *fiber.Ctx) error {
 	slug := c.Params("slug")
 
+	sparse, err := parseSparseQuery(c, projectFieldSpec)
+	if err != nil {
+		return err
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	project, err := h.repo.GetBySlug(ctx, slug)
+	project, err := h.repo.GetBySlug(ctx, slug, sparse.proj)
 	if err != nil {
 		if err.Error() == "record not found" {
 			return fiber.NewError(http.StatusNotFound, "project not found")
@@ -123,7 +191,46 @@ func (h *ProjectHandler) DetailBySlug(c *fiber.Ctx) error {
 		return fiber.NewError(http.StatusInternalServerError, "failed to fetch project")
 	}
 
-	return c.JSON(fiber.Map{
-		"data": projectToResponse(*project),
-	})
+	resp := projectToResponse(*project)
+
+	// head butuh field lengkap (title, shortDesc, cover, tags), jadi tidak dibuat kalau sparse
+	if sparse.fields == nil && sparse.include == nil {
+		head := seo.ProjectHead(h.site, *project)
+		resp.Head = &head
+	}
+
+	if c.Query("nav") == "true" {
+		prev, next, err := h.repo.GetNeighbors(ctx, project, c.Query("sameCategory") == "true")
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("slug", slug).
+				Msg("failed to get project neighbors (public)")
+
+			return fiber.NewError(http.StatusInternalServerError, "failed to fetch project")
+		}
+
+		resp.Prev = projectToNavItem(prev)
+		resp.Next = projectToNavItem(next)
+	}
+
+	if err := sendConditionalJSON(c, fiber.Map{
+		"data": sparse.apply(resp, projectFieldSpec),
+	}, lastModified([]time.Time{project.UpdatedAt}, changes.Projects, changes.Tags)); err != nil {
+		return err
+	}
+
+	// catat view hanya kalau body benar-benar dikirim (bukan 304 revalidasi);
+	// non-blocking, tanpa cookie / IP tersimpan
+	if c.Response().StatusCode() == http.StatusOK {
+		h.views.Record(analytics.View{
+			ProjectID: project.ID,
+			IP:        c.IP(), // IP client asli di belakang proxy terpercaya (PROXY_HEADER)
+			UserAgent: c.Get(fiber.HeaderUserAgent),
+			Referrer:  c.Get(fiber.HeaderReferer),
+			At:        time.Now(),
+		})
+	}
+
+	return nil
 }
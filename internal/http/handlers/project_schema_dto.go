@@ -0,0 +1,33 @@
+package handlers
+
+import (
+	"encoding/json"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+)
+
+type ProjectSchemaCreateRequest struct {
+	Category    string          `json:"category" validate:"required"`
+	Description string          `json:"description"`
+	Schema      json.RawMessage `json:"schema" validate:"required" swaggertype:"object"`
+}
+
+type ProjectSchemaUpdateRequest = ProjectSchemaCreateRequest
+
+type ProjectSchemaResponse struct {
+	ID          string `json:"id"`
+	Category    string `json:"category"`
+	Description string `json:"description,omitempty"`
+	Schema      any    `json:"schema"`
+	UpdatedAt   string `json:"updatedAt"`
+}
+
+func projectSchemaToResponse(s models.ProjectSchema) ProjectSchemaResponse {
+	return ProjectSchemaResponse{
+		ID:          s.ID.String(),
+		Category:    s.Category,
+		Description: s.Description,
+		Schema:      s.Schema,
+		UpdatedAt:   s.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/changes"
+	"github.com/FauzanParanditha/portfolio-backend/internal/config"
+	"github.com/FauzanParanditha/portfolio-backend/internal/jsonresume"
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/FauzanParanditha/portfolio-backend/internal/repository"
+	"github.com/FauzanParanditha/portfolio-backend/internal/skills"
+	"github.com/gofiber/fiber/v2"
+	"github.com/rs/zerolog/log"
+)
+
+// maksimal project di resume
+const resumeProjectLimit = 100
+
+type ResumeHandler struct {
+	exps     repository.ExperienceRepository
+	projects repository.ProjectRepository
+	skills   repository.SkillRepository
+	cfg      *config.Config
+}
+
+func NewResumeHandler(exps repository.ExperienceRepository, projects repository.ProjectRepository, skillRepo repository.SkillRepository, cfg *config.Config) *ResumeHandler {
+	return &ResumeHandler{
+		exps:     exps,
+		projects: projects,
+		skills:   skillRepo,
+		cfg:      cfg,
+	}
+}
+
+// GET /api/v1/resume.json
+// JSON Resume Export godoc
+// @Summary      Export JSON Resume
+// @Description  jsonresume.org v1.0.0 document built from profile config, experiences (with highlights), projects and tag-derived skills
+// @Tags         resume
+// @Produce      json
+// @Param        If-None-Match      header  string  false  "ETag from a previous response"
+// @Param        If-Modified-Since  header  string  false  "Last-Modified from a previous response"
+// @Success      200  {object}  jsonresume.Resume
+// @Success      304  "Not Modified"
+// @Failure      500  {object}  ErrorResponse
+// @Router       /resume.json [get]
+func (h *ResumeHandler) Export(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	exps, _, err := h.exps.ListPublic(ctx, repository.ExperienceListParams{})
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list experiences for resume")
+		return fiber.NewError(http.StatusInternalServerError, "failed to build resume")
+	}
+
+	projects, _, err := h.projects.ListPublic(ctx, repository.ProjectListParams{Limit: resumeProjectLimit})
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list projects for resume")
+		return fiber.NewError(http.StatusInternalServerError, "failed to build resume")
+	}
+
+	src, err := loadSkillSources(ctx, h.skills)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to load skills for resume")
+		return fiber.NewError(http.StatusInternalServerError, "failed to build resume")
+	}
+
+	updated := make([]time.Time, 0, len(exps)+len(projects))
+	for _, e := range exps {
+		updated = append(updated, e.UpdatedAt)
+	}
+	for _, p := range projects {
+		updated = append(updated, p.UpdatedAt)
+	}
+	for _, o := range src.Overrides {
+		updated = append(updated, o.UpdatedAt)
+	}
+	modified := lastModified(updated, changes.Experiences, changes.Projects, changes.Tags, changes.Skills)
+
+	resume := jsonresume.Build(
+		resumeBasics(h.cfg),
+		exps,
+		projects,
+		skills.Build(src, time.Now()),
+		func(p models.Project) string {
+			return h.cfg.SiteBaseURL + "/projects/" + url.PathEscape(p.Slug)
+		},
+		modified,
+	)
+
+	return sendConditionalJSON(c, resume, modified)
+}
+
+// resumeBasics: basics dari config, dipakai resume.json & resume.pdf
+func resumeBasics(cfg *config.Config) jsonresume.Basics {
+	b := jsonresume.Basics{
+		Name:    cfg.ResumeName,
+		Label:   cfg.ResumeLabel,
+		Image:   cfg.ResumeImage,
+		Email:   cfg.ResumeEmail,
+		Phone:   cfg.ResumePhone,
+		URL:     cfg.SiteBaseURL,
+		Summary: cfg.ResumeSummary,
+	}
+
+	if cfg.ResumeCity != "" || cfg.ResumeCountryCode != "" {
+		b.Location = &jsonresume.Location{City: cfg.ResumeCity, CountryCode: cfg.ResumeCountryCode}
+	}
+
+	// "GitHub=https://github.com/x" → username diambil dari segmen path terakhir
+	for _, item := range strings.Split(cfg.ResumeProfiles, ",") {
+		network, link, ok := strings.Cut(strings.TrimSpace(item), "=")
+		if !ok || network == "" || link == "" {
+			continue
+		}
+		p := jsonresume.Profile{Network: strings.TrimSpace(network), URL: strings.TrimSpace(link)}
+		if u, err := url.Parse(p.URL); err == nil {
+			parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+			p.Username = parts[len(parts)-1]
+		}
+		b.Profiles = append(b.Profiles, p)
+	}
+
+	return b
+}
@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"strings"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+)
+
+// Override SEO (request & response). Field kosong / null = fallback ke field tampilan.
+type SEOFields struct {
+	MetaTitle       *string `json:"metaTitle" validate:"omitempty,max=255"`
+	MetaDescription *string `json:"metaDescription" validate:"omitempty,max=500"`
+	CanonicalURL    *string `json:"canonicalUrl" validate:"omitempty,url"`
+	OGImageURL      *string `json:"ogImageUrl" validate:"omitempty,url"`
+}
+
+// nilOrTrimmed: string kosong disimpan sebagai NULL
+func nilOrTrimmed(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	v := strings.TrimSpace(*s)
+	if v == "" {
+		return nil
+	}
+	return &v
+}
+
+// normalizeSEO: dipanggil sebelum validasi, supaya "" tidak gagal di rule url
+func normalizeSEO(req *SEOFields) *SEOFields {
+	if req == nil {
+		return nil
+	}
+	return &SEOFields{
+		MetaTitle:       nilOrTrimmed(req.MetaTitle),
+		MetaDescription: nilOrTrimmed(req.MetaDescription),
+		CanonicalURL:    nilOrTrimmed(req.CanonicalURL),
+		OGImageURL:      nilOrTrimmed(req.OGImageURL),
+	}
+}
+
+func seoFromRequest(req *SEOFields) models.SEO {
+	if req == nil {
+		return models.SEO{}
+	}
+	return models.SEO{
+		MetaTitle:       req.MetaTitle,
+		MetaDescription: req.MetaDescription,
+		CanonicalURL:    req.CanonicalURL,
+		OGImageURL:      req.OGImageURL,
+	}
+}
+
+func seoToResponse(s models.SEO) SEOFields {
+	return SEOFields{
+		MetaTitle:       s.MetaTitle,
+		MetaDescription: s.MetaDescription,
+		CanonicalURL:    s.CanonicalURL,
+		OGImageURL:      s.OGImageURL,
+	}
+}
@@ -0,0 +1,151 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/changes"
+	"github.com/FauzanParanditha/portfolio-backend/internal/config"
+	"github.com/FauzanParanditha/portfolio-backend/internal/repository"
+	"github.com/FauzanParanditha/portfolio-backend/internal/sitemap"
+	"github.com/gofiber/fiber/v2"
+	"github.com/rs/zerolog/log"
+)
+
+// Sitemap & robots.txt di root (bukan /api/v1), tidak masuk swagger.
+// Semua URL memakai SiteBaseURL: frontend diharapkan meneruskan /sitemap*.xml & /robots.txt ke sini.
+type SitemapHandler struct {
+	repo repository.SitemapRepository
+	cfg  *config.Config
+}
+
+func NewSitemapHandler(repo repository.SitemapRepository, cfg *config.Config) *SitemapHandler {
+	return &SitemapHandler{repo: repo, cfg: cfg}
+}
+
+// GET /sitemap.xml
+// Satu <urlset> kalau muat dalam batas protokol, selain itu <sitemapindex> ke /sitemap-N.xml
+func (h *SitemapHandler) Sitemap(c *fiber.Ctx) error {
+	chunks, modified, err := h.chunks()
+	if err != nil {
+		return err
+	}
+
+	if len(chunks) == 1 {
+		return h.sendXML(c, func() ([]byte, error) { return sitemap.URLSet(chunks[0]) }, modified)
+	}
+
+	files := make([]sitemap.URL, 0, len(chunks))
+	for i, chunk := range chunks {
+		files = append(files, sitemap.URL{
+			Loc:     h.cfg.SiteBaseURL + "/sitemap-" + strconv.Itoa(i+1) + ".xml",
+			LastMod: sitemap.LatestMod(chunk),
+		})
+	}
+
+	return h.sendXML(c, func() ([]byte, error) { return sitemap.Index(files) }, modified)
+}
+
+// GET /sitemap-:page.xml (page mulai dari 1)
+func (h *SitemapHandler) Page(c *fiber.Ctx) error {
+	page, err := strconv.Atoi(c.Params("page"))
+	if err != nil || page < 1 {
+		return fiber.NewError(http.StatusNotFound, "sitemap not found")
+	}
+
+	chunks, modified, err := h.chunks()
+	if err != nil {
+		return err
+	}
+
+	// sitemap tunggal tidak punya file anak
+	if len(chunks) == 1 || page > len(chunks) {
+		return fiber.NewError(http.StatusNotFound, "sitemap not found")
+	}
+
+	return h.sendXML(c, func() ([]byte, error) { return sitemap.URLSet(chunks[page-1]) }, modified)
+}
+
+// GET /robots.txt
+func (h *SitemapHandler) Robots(c *fiber.Ctx) error {
+	var b strings.Builder
+
+	b.WriteString("User-agent: *\n")
+	if h.cfg.RobotsDisallowAll {
+		b.WriteString("Disallow: /\n")
+	} else {
+		b.WriteString("Disallow: /api/v1/admin\n")
+		b.WriteString("Disallow: /swagger\n")
+	}
+	b.WriteString("\nSitemap: " + h.cfg.SiteBaseURL + "/sitemap.xml\n")
+
+	c.Set(fiber.HeaderContentType, fiber.MIMETextPlainCharsetUTF8)
+	return c.SendString(b.String())
+}
+
+// chunks: static pages + project + post, dipecah sesuai batas sitemap
+func (h *SitemapHandler) chunks() ([][]sitemap.URL, time.Time, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	projects, err := h.repo.Projects(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list projects for sitemap")
+		return nil, time.Time{}, fiber.NewError(http.StatusInternalServerError, "failed to build sitemap")
+	}
+
+	posts, err := h.repo.Posts(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list posts for sitemap")
+		return nil, time.Time{}, fiber.NewError(http.StatusInternalServerError, "failed to build sitemap")
+	}
+
+	base := h.cfg.SiteBaseURL
+	urls := make([]sitemap.URL, 0, len(projects)+len(posts)+8)
+	updated := make([]time.Time, 0, len(projects)+len(posts))
+
+	for _, path := range strings.Split(h.cfg.SitemapStaticPages, ",") {
+		path = strings.TrimSpace(path)
+		if path == "" {
+			continue
+		}
+		if !strings.HasPrefix(path, "/") {
+			path = "/" + path
+		}
+		urls = append(urls, sitemap.URL{Loc: base + path})
+	}
+
+	for _, p := range projects {
+		lastMod := p.UpdatedAt
+		urls = append(urls, sitemap.URL{Loc: base + "/projects/" + url.PathEscape(p.Slug), LastMod: &lastMod})
+		updated = append(updated, p.UpdatedAt)
+	}
+
+	for _, p := range posts {
+		lastMod := p.UpdatedAt
+		urls = append(urls, sitemap.URL{Loc: base + "/posts/" + url.PathEscape(p.Slug), LastMod: &lastMod})
+		updated = append(updated, p.UpdatedAt)
+	}
+
+	chunks, err := sitemap.Split(urls, sitemap.MaxURLs, sitemap.MaxBytes)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to split sitemap")
+		return nil, time.Time{}, fiber.NewError(http.StatusInternalServerError, "failed to build sitemap")
+	}
+
+	return chunks, lastModified(updated, changes.Projects, changes.Posts), nil
+}
+
+func (h *SitemapHandler) sendXML(c *fiber.Ctx, render func() ([]byte, error), modified time.Time) error {
+	b, err := render()
+	if err != nil {
+		log.Error().Err(err).Msg("failed to render sitemap")
+		return fiber.NewError(http.StatusInternalServerError, "failed to build sitemap")
+	}
+
+	return sendConditional(c, b, sitemap.ContentType, modified)
+}
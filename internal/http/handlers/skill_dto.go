@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/FauzanParanditha/portfolio-backend/internal/skills"
+)
+
+type SkillsResponse struct {
+	Data []skills.Group `json:"data"`
+	Meta interface{}    `json:"meta"`
+}
+
+// Request upsert override skills matrix (per tag)
+type SkillOverrideRequest struct {
+	Proficiency *string `json:"proficiency" validate:"omitempty,oneof=beginner intermediate advanced expert"`
+	SortOrder   *int    `json:"sortOrder"` // null = urut otomatis
+	Hidden      bool    `json:"hidden"`
+}
+
+type SkillOverrideResponse struct {
+	TagID       string  `json:"tagId"`
+	Proficiency *string `json:"proficiency"`
+	SortOrder   *int    `json:"sortOrder"`
+	Hidden      bool    `json:"hidden"`
+	UpdatedAt   string  `json:"updatedAt"`
+}
+
+func skillOverrideToResponse(o models.SkillOverride) SkillOverrideResponse {
+	return SkillOverrideResponse{
+		TagID:       o.TagID.String(),
+		Proficiency: o.Proficiency,
+		SortOrder:   o.SortOrder,
+		Hidden:      o.Hidden,
+		UpdatedAt:   o.UpdatedAt.Format(time.RFC3339),
+	}
+}
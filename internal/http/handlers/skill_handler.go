@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/changes"
+	"github.com/FauzanParanditha/portfolio-backend/internal/repository"
+	"github.com/FauzanParanditha/portfolio-backend/internal/skills"
+	"github.com/gofiber/fiber/v2"
+	"github.com/rs/zerolog/log"
+)
+
+type SkillHandler struct {
+	repo repository.SkillRepository
+}
+
+func NewSkillHandler(repo repository.SkillRepository) *SkillHandler {
+	return &SkillHandler{repo: repo}
+}
+
+// GET /api/v1/skills?type=tech
+// Public Skills Matrix godoc
+// @Summary      Get skills matrix
+// @Description  Skills derived from tags on experiences & projects: total years (overlapping periods merged), project count and last used date, grouped by tag type. Proficiency / order come from admin overrides.
+// @Tags         skills
+// @Accept       json
+// @Produce      json
+// @Param        type  query  string  false  "Only this tag type"
+// @Param        If-None-Match      header  string  false  "ETag from a previous response"
+// @Param        If-Modified-Since  header  string  false  "Last-Modified from a previous response"
+// @Success      200  {object}  SkillsResponse
+// @Success      304  "Not Modified"
+// @Failure      500  {object}  ErrorResponse
+// @Router       /skills [get]
+func (h *SkillHandler) List(c *fiber.Ctx) error {
+	typ := strings.TrimSpace(c.Query("type"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	src, err := loadSkillSources(ctx, h.repo)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to load skills matrix sources")
+		return fiber.NewError(http.StatusInternalServerError, "failed to fetch skills")
+	}
+
+	groups := skills.Build(src, time.Now())
+	if typ != "" {
+		filtered := make([]skills.Group, 0, 1)
+		for _, g := range groups {
+			if strings.EqualFold(g.Type, typ) {
+				filtered = append(filtered, g)
+			}
+		}
+		groups = filtered
+	}
+
+	total := 0
+	for _, g := range groups {
+		total += len(g.Skills)
+	}
+
+	updated := make([]time.Time, 0, len(src.Tags)+len(src.Overrides))
+	for _, t := range src.Tags {
+		updated = append(updated, t.UpdatedAt)
+	}
+	for _, o := range src.Overrides {
+		updated = append(updated, o.UpdatedAt)
+	}
+
+	return sendConditionalJSON(c, fiber.Map{
+		"data": groups,
+		"meta": fiber.Map{
+			"type":  typ,
+			"total": total,
+		},
+	}, lastModified(updated, changes.Skills, changes.Experiences, changes.Projects, changes.Tags))
+}
+
+// loadSkillSources: data mentah skills matrix (dipakai juga oleh resume export)
+func loadSkillSources(ctx context.Context, repo repository.SkillRepository) (skills.Sources, error) {
+	var (
+		src skills.Sources
+		err error
+	)
+
+	if src.Tags, err = repo.Tags(ctx); err != nil {
+		return src, err
+	}
+	if src.Periods, err = repo.Periods(ctx); err != nil {
+		return src, err
+	}
+	if src.Projects, err = repo.ProjectStats(ctx); err != nil {
+		return src, err
+	}
+	if src.Overrides, err = repo.ListOverrides(ctx); err != nil {
+		return src, err
+	}
+
+	return src, nil
+}
@@ -0,0 +1,246 @@
+package handlers
+
+import (
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/repository"
+	"github.com/gofiber/fiber/v2"
+)
+
+// fieldSpec: mapping nama field JSON response → kolom DB / relasi gorm
+type fieldSpec struct {
+	columns   map[string]string
+	relations map[string]string
+	// field JSON yang berasal dari beberapa kolom (mis. seo)
+	groups map[string][]string
+	// kolom yang selalu di-select (primary key, sort key untuk cursor, dll)
+	always []string
+}
+
+var projectFieldSpec = fieldSpec{
+	columns: map[string]string{
+		"id":               "id",
+		"title":            "title",
+		"slug":             "slug",
+		"shortDesc":        "short_desc",
+		"longDescription":  "long_desc",
+		"coverImageUrl":    "cover_image_url",
+		"category":         "category",
+		"timeline":         "timeline",
+		"role":             "role",
+		"challenge":        "challenge",
+		"solution":         "solution",
+		"results":          "results",
+		"technicalDetails": "technical_details",
+		"demoUrl":          "demo_url",
+		"repoUrl":          "repo_url",
+		"isFeatured":       "is_featured",
+		"sortOrder":        "sort_order",
+	},
+	groups: map[string][]string{
+		"seo": {"meta_title", "meta_description", "canonical_url", "og_image_url"},
+	},
+	relations: map[string]string{
+		"tags":        "Tags",
+		"features":    "Features",
+		"screenshots": "Screenshots",
+		"experiences": "Experiences",
+		"client":      "Client",
+	},
+	// client_id wajib ikut supaya preload Client bisa jalan
+	always: []string{"id", "slug", "category", "sort_order", "created_at", "updated_at", "client_id"},
+}
+
+var experienceFieldSpec = fieldSpec{
+	columns: map[string]string{
+		"id":          "id",
+		"title":       "title",
+		"company":     "company",
+		"location":    "location",
+		"startDate":   "start_date",
+		"endDate":     "end_date",
+		"isCurrent":   "is_current",
+		"description": "description",
+		"sortOrder":   "sort_order",
+
+		"employmentType": "employment_type",
+	},
+	relations: map[string]string{
+		"tags":         "Tags",
+		"highlights":   "Highlights",
+		"projects":     "Projects",
+		"organization": "Organization",
+	},
+	always: []string{"id", "sort_order", "start_date", "created_at", "updated_at", "organization_id"},
+}
+
+// sparseQuery: hasil parse ?fields= & ?include=
+type sparseQuery struct {
+	fields  map[string]bool // nil = semua field
+	include map[string]bool // nil = semua relasi
+	proj    repository.Projection
+}
+
+func splitQueryList(s string) []string {
+	var out []string
+	for _, p := range strings.Split(s, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// Helper: parse ?fields=title,slug,tags&include=features,screenshots
+//
+//   - include diisi → hanya relasi itu yang di-preload
+//   - hanya fields → relasi yang ikut di-preload = relasi yang disebut di fields
+//   - fields diisi → hanya kolom itu (+ kolom wajib) yang di-select
+func parseSparseQuery(c *fiber.Ctx, spec fieldSpec) (sparseQuery, error) {
+	var sq sparseQuery
+
+	fields := splitQueryList(c.Query("fields"))
+	include := splitQueryList(c.Query("include"))
+
+	if len(include) > 0 {
+		sq.include = map[string]bool{}
+		sq.proj.Relations = map[string]bool{}
+		for _, name := range include {
+			rel, ok := spec.relations[name]
+			if !ok {
+				return sq, fiber.NewError(http.StatusBadRequest, "invalid include: "+name)
+			}
+			sq.include[name] = true
+			sq.proj.Relations[rel] = true
+		}
+	}
+
+	if len(fields) == 0 {
+		return sq, nil
+	}
+
+	sq.fields = map[string]bool{"id": true}
+	if sq.proj.Relations == nil {
+		sq.proj.Relations = map[string]bool{}
+	}
+
+	cols := map[string]bool{}
+	for _, col := range spec.always {
+		cols[col] = true
+	}
+
+	for _, name := range fields {
+		if col, ok := spec.columns[name]; ok {
+			cols[col] = true
+		} else if group, ok := spec.groups[name]; ok {
+			for _, col := range group {
+				cols[col] = true
+			}
+		} else if rel, ok := spec.relations[name]; ok {
+			sq.proj.Relations[rel] = true
+		} else {
+			return sq, fiber.NewError(http.StatusBadRequest, "invalid field: "+name)
+		}
+		sq.fields[name] = true
+	}
+
+	for col := range cols {
+		sq.proj.Columns = append(sq.proj.Columns, col)
+	}
+	// urutan stabil: SQL yang sama untuk request yang sama (log, statement cache)
+	sort.Strings(sq.proj.Columns)
+
+	return sq, nil
+}
+
+// keep: apakah key JSON ini tetap ada di response
+func (sq sparseQuery) keep(key string, spec fieldSpec) bool {
+	if _, isRel := spec.relations[key]; isRel {
+		if sq.fields != nil && sq.fields[key] {
+			return true
+		}
+		if sq.include != nil {
+			return sq.include[key]
+		}
+		return sq.fields == nil
+	}
+
+	return sq.fields == nil || sq.fields[key]
+}
+
+// apply: buang key yang tidak diminta. Tanpa fields/include, v dikembalikan apa adanya.
+// v harus struct response (atau pointer ke struct); field dibaca langsung lewat tag json.
+func (sq sparseQuery) apply(v any, spec fieldSpec) any {
+	if sq.fields == nil && sq.include == nil {
+		return v
+	}
+
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return v
+	}
+	rt := rv.Type()
+
+	m := make(map[string]any, rt.NumField())
+	for i := 0; i < rt.NumField(); i++ {
+		f := rt.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		key, opts, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if key == "-" {
+			continue
+		}
+		if key == "" {
+			key = f.Name
+		}
+
+		// navigasi prev/next & head (detail) tidak termasuk sparse fields
+		if key != "prev" && key != "next" && key != "head" && !sq.keep(key, spec) {
+			continue
+		}
+
+		fv := rv.Field(i)
+		if strings.Contains(","+opts+",", ",omitempty,") && emptyJSONValue(fv) {
+			continue
+		}
+		m[key] = fv.Interface()
+	}
+
+	return m
+}
+
+// emptyJSONValue: aturan omitempty encoding/json
+func emptyJSONValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Pointer:
+		return v.IsNil()
+	}
+	return false
+}
+
+func sparseList[T any](sq sparseQuery, items []T, spec fieldSpec) any {
+	if sq.fields == nil && sq.include == nil {
+		return items
+	}
+
+	out := make([]any, 0, len(items))
+	for _, item := range items {
+		out = append(out, sq.apply(item, spec))
+	}
+	return out
+}
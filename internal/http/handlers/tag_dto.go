@@ -1,6 +1,9 @@
 package handlers
 
-import "github.com/FauzanParanditha/portfolio-backend/internal/models"
+import (
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/FauzanParanditha/portfolio-backend/internal/pagination"
+)
 
 type TagCreateRequest struct {
 	Name string `json:"name" validate:"required"`
@@ -10,16 +13,22 @@ type TagCreateRequest struct {
 type TagUpdateRequest = TagCreateRequest
 
 type TagResponse struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
-	Type string `json:"type"`
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Type      string `json:"type"`
+	SortOrder int    `json:"sortOrder"`
 }
 
 func tagToResponse(t models.Tag) TagResponse {
 	return TagResponse{
-		ID:   t.ID.String(),
-		Name: t.Name,
-		Type: t.Type,
+		ID:        t.ID.String(),
+		Name:      t.Name,
+		Type:      t.Type,
+		SortOrder: t.SortOrder,
 	}
 }
 
+// sort key tag untuk cursor pagination
+func tagCursor(t models.Tag) pagination.Cursor {
+	return pagination.Cursor{SortOrder: t.SortOrder, CreatedAt: t.CreatedAt, ID: t.ID}
+}
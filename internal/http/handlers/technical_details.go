@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/repository"
+	"github.com/FauzanParanditha/portfolio-backend/internal/validation"
+	"github.com/google/uuid"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// Schema hasil compile per kategori. Versi = id + updated_at schema,
+// jadi edit schema lewat admin otomatis bikin compile ulang.
+type compiledSchema struct {
+	id        uuid.UUID
+	updatedAt time.Time
+	schema    *jsonschema.Schema
+}
+
+var (
+	compiledSchemasMu sync.Mutex
+	compiledSchemas   = map[string]compiledSchema{}
+)
+
+func compiledSchemaFor(category string, id uuid.UUID, updatedAt time.Time, raw []byte) (*jsonschema.Schema, error) {
+	compiledSchemasMu.Lock()
+	cs, ok := compiledSchemas[category]
+	compiledSchemasMu.Unlock()
+
+	if ok && cs.id == id && cs.updatedAt.Equal(updatedAt) {
+		return cs.schema, nil
+	}
+
+	sch, err := validation.CompileJSONSchema(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	compiledSchemasMu.Lock()
+	compiledSchemas[category] = compiledSchema{id: id, updatedAt: updatedAt, schema: sch}
+	compiledSchemasMu.Unlock()
+
+	return sch, nil
+}
+
+// Helper: marshal technicalDetails (map -> JSON) lalu validasi dengan
+// JSON Schema milik kategori project, kalau kategori tsb punya schema.
+func prepareTechnicalDetails(ctx context.Context, schemas repository.ProjectSchemaRepository, category string, details map[string]any) (datatypes.JSON, map[string]string, error) {
+	raw := []byte("{}")
+	var technicalDetails datatypes.JSON
+
+	if details != nil {
+		b, err := json.Marshal(details)
+		if err != nil {
+			return nil, map[string]string{"technicalDetails": "format JSON tidak valid"}, nil
+		}
+		raw = b
+		technicalDetails = datatypes.JSON(b)
+	}
+
+	if category == "" {
+		return technicalDetails, nil, nil
+	}
+
+	schema, err := schemas.GetByCategory(ctx, category)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return technicalDetails, nil, nil
+		}
+		return nil, nil, err
+	}
+
+	sch, err := compiledSchemaFor(category, schema.ID, schema.UpdatedAt, schema.Schema)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var doc any
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, map[string]string{"technicalDetails": "format JSON tidak valid"}, nil
+	}
+
+	fieldErrors, err := validation.ValidateCompiled(sch, doc, "technicalDetails")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return technicalDetails, fieldErrors, nil
+}
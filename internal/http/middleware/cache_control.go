@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// CachePolicy: nilai Cache-Control per route (detik)
+type CachePolicy struct {
+	MaxAge               int
+	StaleWhileRevalidate int
+}
+
+func (p CachePolicy) header() string {
+	if p.MaxAge <= 0 && p.StaleWhileRevalidate <= 0 {
+		// tetap boleh di-cache, tapi wajib revalidate (ETag / Last-Modified)
+		return "public, no-cache"
+	}
+
+	v := "public, max-age=" + strconv.Itoa(p.MaxAge)
+	if p.StaleWhileRevalidate > 0 {
+		v += ", stale-while-revalidate=" + strconv.Itoa(p.StaleWhileRevalidate)
+	}
+	return v
+}
+
+// CacheControl memasang header Cache-Control hanya untuk response 200 / 304,
+// supaya error tidak ikut di-cache CDN.
+func CacheControl(p CachePolicy) fiber.Handler {
+	value := p.header()
+
+	return func(c *fiber.Ctx) error {
+		if err := c.Next(); err != nil {
+			return err
+		}
+
+		switch c.Response().StatusCode() {
+		case fiber.StatusOK, fiber.StatusNotModified:
+			c.Set(fiber.HeaderCacheControl, value)
+		}
+
+		return nil
+	}
+}
@@ -2,11 +2,16 @@ package http
 
 import (
 	"net/http"
+	"strings"
 
+	"github.com/FauzanParanditha/portfolio-backend/internal/analytics"
+	"github.com/FauzanParanditha/portfolio-backend/internal/cache"
 	"github.com/FauzanParanditha/portfolio-backend/internal/config"
+	"github.com/FauzanParanditha/portfolio-backend/internal/contenthealth"
 	"github.com/FauzanParanditha/portfolio-backend/internal/http/handlers"
 	"github.com/FauzanParanditha/portfolio-backend/internal/http/middleware"
 	"github.com/FauzanParanditha/portfolio-backend/internal/repository"
+	"github.com/FauzanParanditha/portfolio-backend/internal/seo"
 	"github.com/gofiber/fiber/v2"
 	"gorm.io/gorm"
 
@@ -16,11 +21,23 @@ import (
 type AppDeps struct {
 	DB     *gorm.DB
 	Config *config.Config
+
+	// ViewRecorder boleh nil (analytics dimatikan)
+	ViewRecorder *analytics.Recorder
+
+	// Cache boleh nil (cache repository dimatikan)
+	Cache *cache.Cache
 }
 
 func NewRouter(deps AppDeps) *fiber.App {
 	app := fiber.New(fiber.Config{
 		ErrorHandler: NewErrorHandler(),
+
+		// c.IP() = IP client asli kalau request datang dari proxy terpercaya
+		ProxyHeader:             deps.Config.ProxyHeader,
+		EnableTrustedProxyCheck: deps.Config.ProxyHeader != "",
+		TrustedProxies:          splitList(deps.Config.TrustedProxies),
+		EnableIPValidation:      true,
 	})
 
 	middleware.RegisterGlobal(app, deps.Config)
@@ -35,13 +52,33 @@ func NewRouter(deps AppDeps) *fiber.App {
 
 	registerPublicProjectRoutes(app, deps)
 	registerPublicExperienceRoutes(app, deps)
+	registerPublicPostRoutes(app, deps)
+	registerPublicEducationRoutes(app, deps)
+	registerPublicCertificationRoutes(app, deps)
+	registerPublicAwardRoutes(app, deps)
+	registerPublicSkillRoutes(app, deps)
+	registerPublicResumeRoutes(app, deps)
+	registerPublicPDFRoutes(app, deps)
 	registerPublicContactRoutes(app, deps)
+	registerFeedRoutes(app, deps)
+	registerSitemapRoutes(app, deps)
 
 	registerAdminDasbboardRoute(app, deps)
 	registerAdminProjectRoutes(app, deps)
 	registerAdminTagRoutes(app, deps)
+	registerAdminProjectSchemaRoutes(app, deps)
 	registerAdminExperienceRoutes(app, deps)
+	registerAdminPostRoutes(app, deps)
+	registerAdminEducationRoutes(app, deps)
+	registerAdminCertificationRoutes(app, deps)
+	registerAdminAwardRoutes(app, deps)
+	registerAdminOrganizationRoutes(app, deps)
+	registerAdminSkillRoutes(app, deps)
+	registerAdminImportRoutes(app, deps)
 	registerAdminContactRoutes(app, deps)
+	registerAdminLinkHealthRoutes(app, deps)
+	registerAdminAnalyticsRoutes(app, deps)
+	registerAdminCacheRoutes(app, deps)
 
 	return app
 }
@@ -74,15 +111,60 @@ func registerHealthRoutes(app *fiber.App, deps AppDeps) {
 	})
 }
 
+// siteInfo: info situs untuk SEO head / JSON-LD
+func siteInfo(cfg *config.Config) seo.Site {
+	return seo.Site{BaseURL: cfg.SiteBaseURL, Author: cfg.SiteAuthor}
+}
+
 // Public project routes (yang sebelumnya sudah ada)
 func registerPublicProjectRoutes(app *fiber.App, deps AppDeps) {
-	projectRepo := repository.NewProjectRepository(deps.DB)
-	projectHandler := handlers.NewProjectHandler(projectRepo)
+	projectRepo := repository.NewCachedProjectRepository(repository.NewProjectRepository(deps.DB), deps.Cache)
+	projectHandler := handlers.NewProjectHandler(projectRepo, deps.ViewRecorder, siteInfo(deps.Config))
 
 	api := app.Group("/api/v1")
+	listCache := middleware.CacheControl(middleware.CachePolicy{
+		MaxAge:               deps.Config.CacheProjectsMaxAge,
+		StaleWhileRevalidate: deps.Config.CacheProjectsSWR,
+	})
+	detailCache := middleware.CacheControl(middleware.CachePolicy{
+		MaxAge:               deps.Config.CacheProjectDetailMaxAge,
+		StaleWhileRevalidate: deps.Config.CacheProjectDetailSWR,
+	})
+
 	projects := api.Group("/projects")
-	projects.Get("/", projectHandler.List)
-	projects.Get("/:slug", projectHandler.DetailBySlug)
+	projects.Get("/", listCache, projectHandler.List)
+	projects.Get("/:slug", detailCache, projectHandler.DetailBySlug)
+}
+
+// Feed routes (RSS / Atom / JSON Feed), di root bukan /api/v1
+func registerFeedRoutes(app *fiber.App, deps AppDeps) {
+	projectRepo := repository.NewCachedProjectRepository(repository.NewProjectRepository(deps.DB), deps.Cache)
+	handler := handlers.NewFeedHandler(projectRepo, deps.Config)
+
+	cache := middleware.CacheControl(middleware.CachePolicy{
+		MaxAge:               deps.Config.CacheFeedsMaxAge,
+		StaleWhileRevalidate: deps.Config.CacheFeedsSWR,
+	})
+
+	feeds := app.Group("/feeds")
+	feeds.Get("/projects.rss", cache, handler.ProjectsRSS)
+	feeds.Get("/projects.atom", cache, handler.ProjectsAtom)
+	feeds.Get("/projects.json", cache, handler.ProjectsJSON)
+}
+
+// Sitemap & robots.txt, di root bukan /api/v1
+func registerSitemapRoutes(app *fiber.App, deps AppDeps) {
+	repo := repository.NewSitemapRepository(deps.DB)
+	handler := handlers.NewSitemapHandler(repo, deps.Config)
+
+	cache := middleware.CacheControl(middleware.CachePolicy{
+		MaxAge:               deps.Config.CacheSitemapMaxAge,
+		StaleWhileRevalidate: deps.Config.CacheSitemapSWR,
+	})
+
+	app.Get("/sitemap.xml", cache, handler.Sitemap)
+	app.Get("/sitemap-:page.xml", cache, handler.Page)
+	app.Get("/robots.txt", cache, handler.Robots)
 }
 
 // Auth routes
@@ -106,8 +188,105 @@ func registerAdminProjectRoutes(app *fiber.App, deps AppDeps) {
 	p.Get("/", adminProjectHandler.List)
 	p.Get("/:id", adminProjectHandler.GetByID)
 	p.Post("/", adminProjectHandler.Create)
+	p.Put("/order", adminProjectHandler.Reorder) // sebelum /:id
 	p.Put("/:id", adminProjectHandler.Update)
+	p.Post("/:id/duplicate", adminProjectHandler.Duplicate)
 	p.Delete("/:id", adminProjectHandler.Delete)
+
+	// sub-resource: ID anak stabil, urutan lewat PATCH .../order
+	p.Post("/:id/features", adminProjectHandler.CreateFeature)
+	p.Patch("/:id/features/order", adminProjectHandler.ReorderFeatures)
+	p.Put("/:id/features/:childId", adminProjectHandler.UpdateFeature)
+	p.Delete("/:id/features/:childId", adminProjectHandler.DeleteFeature)
+
+	p.Post("/:id/screenshots", adminProjectHandler.CreateScreenshot)
+	p.Patch("/:id/screenshots/order", adminProjectHandler.ReorderScreenshots)
+	p.Put("/:id/screenshots/:childId", adminProjectHandler.UpdateScreenshot)
+	p.Delete("/:id/screenshots/:childId", adminProjectHandler.DeleteScreenshot)
+}
+
+// Public skills matrix
+func registerPublicSkillRoutes(app *fiber.App, deps AppDeps) {
+	handler := handlers.NewSkillHandler(repository.NewSkillRepository(deps.DB))
+
+	api := app.Group("/api/v1")
+	cache := middleware.CacheControl(middleware.CachePolicy{
+		MaxAge:               deps.Config.CacheSkillsMaxAge,
+		StaleWhileRevalidate: deps.Config.CacheSkillsSWR,
+	})
+
+	api.Get("/skills", cache, handler.List)
+}
+
+// Public JSON Resume export
+func registerPublicResumeRoutes(app *fiber.App, deps AppDeps) {
+	handler := handlers.NewResumeHandler(
+		repository.NewCachedExperienceRepository(repository.NewExperienceRepository(deps.DB), deps.Cache),
+		repository.NewCachedProjectRepository(repository.NewProjectRepository(deps.DB), deps.Cache),
+		repository.NewSkillRepository(deps.DB),
+		deps.Config,
+	)
+
+	api := app.Group("/api/v1")
+	cache := middleware.CacheControl(middleware.CachePolicy{
+		MaxAge:               deps.Config.CacheExperiencesMaxAge,
+		StaleWhileRevalidate: deps.Config.CacheExperiencesSWR,
+	})
+
+	api.Get("/resume.json", cache, handler.Export)
+}
+
+// Public PDF (resume & project case study)
+func registerPublicPDFRoutes(app *fiber.App, deps AppDeps) {
+	handler := handlers.NewPDFHandler(
+		repository.NewCachedExperienceRepository(repository.NewExperienceRepository(deps.DB), deps.Cache),
+		repository.NewCachedProjectRepository(repository.NewProjectRepository(deps.DB), deps.Cache),
+		repository.NewSkillRepository(deps.DB),
+		deps.Config,
+	)
+
+	api := app.Group("/api/v1")
+	resumeCache := middleware.CacheControl(middleware.CachePolicy{
+		MaxAge:               deps.Config.CacheExperiencesMaxAge,
+		StaleWhileRevalidate: deps.Config.CacheExperiencesSWR,
+	})
+	projectCache := middleware.CacheControl(middleware.CachePolicy{
+		MaxAge:               deps.Config.CacheProjectDetailMaxAge,
+		StaleWhileRevalidate: deps.Config.CacheProjectDetailSWR,
+	})
+
+	api.Get("/resume.pdf", resumeCache, handler.Resume)
+	api.Get("/projects/:slug/case-study.pdf", projectCache, handler.CaseStudy)
+}
+
+// Admin import routes
+func registerAdminImportRoutes(app *fiber.App, deps AppDeps) {
+	api := app.Group("/api/v1")
+
+	admin := api.Group("/admin")
+	admin.Use(middleware.AuthJWT(deps.Config))
+
+	handler := handlers.NewAdminImportHandler(deps.DB, deps.Config)
+
+	admin.Post("/import/json-resume", handler.JSONResume)
+}
+
+// Admin skill override routes
+func registerAdminSkillRoutes(app *fiber.App, deps AppDeps) {
+	api := app.Group("/api/v1")
+
+	admin := api.Group("/admin")
+	admin.Use(middleware.AuthJWT(deps.Config))
+
+	handler := handlers.NewAdminSkillHandler(
+		repository.NewSkillRepository(deps.DB),
+		repository.NewTagRepository(deps.DB),
+	)
+
+	s := admin.Group("/skills/overrides")
+	s.Get("/", handler.List)
+	s.Put("/:tagId", handler.Upsert)
+	s.Delete("/:tagId", handler.Delete)
 }
 
 // Admin tag routes
@@ -118,24 +297,48 @@ func registerAdminTagRoutes(app *fiber.App, deps AppDeps) {
 	admin.Use(middleware.AuthJWT(deps.Config))
 
 	repo := repository.NewTagRepository(deps.DB)
-	handler := handlers.NewAdminTagHandler(repo)
+	handler := handlers.NewAdminTagHandler(repo, deps.DB)
 
 	t := admin.Group("/tags")
 	t.Get("/", handler.List)
 	t.Get("/:id", handler.GetByID)
 	t.Post("/", handler.Create)
+	t.Put("/order", handler.Reorder) // sebelum /:id
 	t.Put("/:id", handler.Update)
 	t.Delete("/:id", handler.Delete)
 }
 
+// Admin project schema routes (JSON Schema technicalDetails per kategori)
+func registerAdminProjectSchemaRoutes(app *fiber.App, deps AppDeps) {
+	api := app.Group("/api/v1")
+
+	admin := api.Group("/admin")
+	admin.Use(middleware.AuthJWT(deps.Config))
+
+	repo := repository.NewProjectSchemaRepository(deps.DB)
+	handler := handlers.NewAdminProjectSchemaHandler(repo)
+
+	s := admin.Group("/project-schemas")
+	s.Get("/", handler.List)
+	s.Get("/:id", handler.GetByID)
+	s.Post("/", handler.Create)
+	s.Put("/:id", handler.Update)
+	s.Delete("/:id", handler.Delete)
+}
+
 // Public experience route
 func registerPublicExperienceRoutes(app *fiber.App, deps AppDeps) {
-	expRepo := repository.NewExperienceRepository(deps.DB)
+	expRepo := repository.NewCachedExperienceRepository(repository.NewExperienceRepository(deps.DB), deps.Cache)
 	expHandler := handlers.NewExperienceHandler(expRepo)
 
 	api := app.Group("/api/v1")
+	cache := middleware.CacheControl(middleware.CachePolicy{
+		MaxAge:               deps.Config.CacheExperiencesMaxAge,
+		StaleWhileRevalidate: deps.Config.CacheExperiencesSWR,
+	})
+
 	exps := api.Group("/experiences")
-	exps.Get("/", expHandler.List)
+	exps.Get("/", cache, expHandler.List)
 }
 
 // Admin experience route
@@ -151,8 +354,47 @@ func registerAdminExperienceRoutes(app *fiber.App, deps AppDeps) {
 	e.Get("/", handler.List)
 	e.Get("/:id", handler.GetByID)
 	e.Post("/", handler.Create)
+	e.Put("/order", handler.Reorder) // sebelum /:id
 	e.Put("/:id", handler.Update)
 	e.Delete("/:id", handler.Delete)
+
+	e.Post("/:id/highlights", handler.CreateHighlight)
+	e.Patch("/:id/highlights/order", handler.ReorderHighlights)
+	e.Put("/:id/highlights/:childId", handler.UpdateHighlight)
+	e.Delete("/:id/highlights/:childId", handler.DeleteHighlight)
+}
+
+// Public post (blog) routes
+func registerPublicPostRoutes(app *fiber.App, deps AppDeps) {
+	postRepo := repository.NewCachedPostRepository(repository.NewPostRepository(deps.DB), deps.Cache)
+	postHandler := handlers.NewPostHandler(postRepo, siteInfo(deps.Config))
+
+	cache := middleware.CacheControl(middleware.CachePolicy{
+		MaxAge:               deps.Config.CachePostsMaxAge,
+		StaleWhileRevalidate: deps.Config.CachePostsSWR,
+	})
+
+	api := app.Group("/api/v1")
+	posts := api.Group("/posts")
+	posts.Get("/", cache, postHandler.List)
+	posts.Get("/:slug", cache, postHandler.DetailBySlug)
+}
+
+// Admin post (blog) routes
+func registerAdminPostRoutes(app *fiber.App, deps AppDeps) {
+	api := app.Group("/api/v1")
+
+	admin := api.Group("/admin")
+	admin.Use(middleware.AuthJWT(deps.Config))
+
+	handler := handlers.NewAdminPostHandler(deps.DB)
+
+	p := admin.Group("/posts")
+	p.Get("/", handler.List)
+	p.Get("/:id", handler.GetByID)
+	p.Post("/", handler.Create)
+	p.Put("/:id", handler.Update)
+	p.Delete("/:id", handler.Delete)
 }
 
 // Public contact route
@@ -197,6 +439,173 @@ func registerAdminDasbboardRoute(app *fiber.App, deps AppDeps) {
 	admin := api.Group("/admin")
 	admin.Use(middleware.AuthJWT(deps.Config))
 
-	dashboardHandler := handlers.NewAdminDashboardHandler(deps.DB)
+	dashboardRepo := repository.NewDashboardRepository(deps.DB)
+	health := contenthealth.New(
+		repository.NewContentHealthRepository(deps.DB),
+		strings.Split(deps.Config.ContentHealthDisabledRules, ","),
+	)
+
+	dashboardHandler := handlers.NewAdminDashboardHandler(dashboardRepo, health)
 	admin.Get("/dashboard/overview", dashboardHandler.Overview)
-}
\ No newline at end of file
+	admin.Get("/dashboard/timeseries", dashboardHandler.Timeseries)
+	admin.Get("/dashboard/health", dashboardHandler.Health)
+}
+
+// Admin link health route
+func registerAdminLinkHealthRoutes(app *fiber.App, deps AppDeps) {
+	api := app.Group("/api/v1")
+
+	admin := api.Group("/admin")
+	admin.Use(middleware.AuthJWT(deps.Config))
+
+	repo := repository.NewLinkCheckRepository(deps.DB)
+	handler := handlers.NewAdminLinkHealthHandler(repo)
+
+	admin.Get("/link-health", handler.List)
+}
+
+// Admin analytics routes
+func registerAdminAnalyticsRoutes(app *fiber.App, deps AppDeps) {
+	api := app.Group("/api/v1")
+
+	admin := api.Group("/admin")
+	admin.Use(middleware.AuthJWT(deps.Config))
+
+	repo := repository.NewAnalyticsRepository(deps.DB)
+	handler := handlers.NewAdminAnalyticsHandler(repo)
+
+	a := admin.Group("/analytics")
+	a.Get("/projects/top", handler.TopProjects)
+	a.Get("/projects/:id/timeseries", handler.ProjectTimeseries)
+}
+
+// Admin cache routes
+func registerAdminCacheRoutes(app *fiber.App, deps AppDeps) {
+	api := app.Group("/api/v1")
+
+	admin := api.Group("/admin")
+	admin.Use(middleware.AuthJWT(deps.Config))
+
+	handler := handlers.NewAdminCacheHandler(deps.Cache)
+
+	admin.Get("/cache/stats", handler.Stats)
+}
+
+// Public education (cache policy sama dengan experiences)
+func registerPublicEducationRoutes(app *fiber.App, deps AppDeps) {
+	handler := handlers.NewEducationHandler(repository.NewEducationRepository(deps.DB))
+
+	api := app.Group("/api/v1")
+	cache := middleware.CacheControl(middleware.CachePolicy{
+		MaxAge:               deps.Config.CacheExperiencesMaxAge,
+		StaleWhileRevalidate: deps.Config.CacheExperiencesSWR,
+	})
+
+	api.Get("/education", cache, handler.List)
+}
+
+// Public certifications
+func registerPublicCertificationRoutes(app *fiber.App, deps AppDeps) {
+	handler := handlers.NewCertificationHandler(repository.NewCertificationRepository(deps.DB))
+
+	api := app.Group("/api/v1")
+	cache := middleware.CacheControl(middleware.CachePolicy{
+		MaxAge:               deps.Config.CacheExperiencesMaxAge,
+		StaleWhileRevalidate: deps.Config.CacheExperiencesSWR,
+	})
+
+	api.Get("/certifications", cache, handler.List)
+}
+
+// Public awards
+func registerPublicAwardRoutes(app *fiber.App, deps AppDeps) {
+	handler := handlers.NewAwardHandler(repository.NewAwardRepository(deps.DB))
+
+	api := app.Group("/api/v1")
+	cache := middleware.CacheControl(middleware.CachePolicy{
+		MaxAge:               deps.Config.CacheExperiencesMaxAge,
+		StaleWhileRevalidate: deps.Config.CacheExperiencesSWR,
+	})
+
+	api.Get("/awards", cache, handler.List)
+}
+
+// Admin education routes
+func registerAdminEducationRoutes(app *fiber.App, deps AppDeps) {
+	api := app.Group("/api/v1")
+
+	admin := api.Group("/admin")
+	admin.Use(middleware.AuthJWT(deps.Config))
+
+	handler := handlers.NewAdminEducationHandler(deps.DB)
+
+	g := admin.Group("/education")
+	g.Get("/", handler.List)
+	g.Get("/:id", handler.GetByID)
+	g.Post("/", handler.Create)
+	g.Put("/:id", handler.Update)
+	g.Delete("/:id", handler.Delete)
+}
+
+// Admin certifications routes
+func registerAdminCertificationRoutes(app *fiber.App, deps AppDeps) {
+	api := app.Group("/api/v1")
+
+	admin := api.Group("/admin")
+	admin.Use(middleware.AuthJWT(deps.Config))
+
+	handler := handlers.NewAdminCertificationHandler(deps.DB)
+
+	g := admin.Group("/certifications")
+	g.Get("/", handler.List)
+	g.Get("/:id", handler.GetByID)
+	g.Post("/", handler.Create)
+	g.Put("/:id", handler.Update)
+	g.Delete("/:id", handler.Delete)
+}
+
+// Admin awards routes
+func registerAdminAwardRoutes(app *fiber.App, deps AppDeps) {
+	api := app.Group("/api/v1")
+
+	admin := api.Group("/admin")
+	admin.Use(middleware.AuthJWT(deps.Config))
+
+	handler := handlers.NewAdminAwardHandler(deps.DB)
+
+	g := admin.Group("/awards")
+	g.Get("/", handler.List)
+	g.Get("/:id", handler.GetByID)
+	g.Post("/", handler.Create)
+	g.Put("/:id", handler.Update)
+	g.Delete("/:id", handler.Delete)
+}
+
+// Admin organizations routes (employer / client)
+func registerAdminOrganizationRoutes(app *fiber.App, deps AppDeps) {
+	api := app.Group("/api/v1")
+
+	admin := api.Group("/admin")
+	admin.Use(middleware.AuthJWT(deps.Config))
+
+	handler := handlers.NewAdminOrganizationHandler(deps.DB)
+
+	g := admin.Group("/organizations")
+	g.Get("/", handler.List)
+	g.Get("/:id", handler.GetByID)
+	g.Post("/", handler.Create)
+	g.Put("/:id", handler.Update)
+	g.Delete("/:id", handler.Delete)
+	g.Post("/:id/merge", handler.Merge)
+}
+
+// splitList: "a, b,,c" → [a b c]
+func splitList(s string) []string {
+	var out []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
@@ -0,0 +1,83 @@
+package jsonresume
+
+import (
+	"strings"
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/FauzanParanditha/portfolio-backend/internal/skills"
+)
+
+const dateLayout = "2006-01-02"
+
+// Build: dokumen JSON Resume dari data portfolio
+func Build(basics Basics, exps []models.Experience, projects []models.Project, groups []skills.Group, projectURL func(models.Project) string, modified time.Time) Resume {
+	r := Resume{
+		Schema:   SchemaURL,
+		Basics:   basics,
+		Work:     make([]Work, 0, len(exps)),
+		Projects: make([]Project, 0, len(projects)),
+		Skills:   []Skill{},
+		Meta: &Meta{
+			Version: "v1.0.0",
+		},
+	}
+	if !modified.IsZero() {
+		r.Meta.LastModified = modified.UTC().Format(time.RFC3339)
+	}
+	if basics.URL != "" {
+		r.Meta.Canonical = strings.TrimRight(basics.URL, "/") + "/resume.json"
+	}
+
+	for _, e := range exps {
+		w := Work{
+			Name:      e.Company,
+			Position:  e.Title,
+			Location:  e.Location,
+			StartDate: e.StartDate.Format(dateLayout),
+			Summary:   e.Description,
+		}
+		if !e.IsCurrent && e.EndDate != nil {
+			w.EndDate = e.EndDate.Format(dateLayout)
+		}
+		for _, h := range e.Highlights {
+			w.Highlights = append(w.Highlights, h.Text)
+		}
+		r.Work = append(r.Work, w)
+	}
+
+	for _, p := range projects {
+		proj := Project{
+			Name:        p.Title,
+			Description: p.ShortDesc,
+			URL:         projectURL(p),
+			Type:        p.Category,
+		}
+		if p.Role != "" {
+			proj.Roles = []string{p.Role}
+		}
+		for _, f := range p.Features {
+			proj.Highlights = append(proj.Highlights, f.Text)
+		}
+		for _, t := range p.Tags {
+			proj.Keywords = append(proj.Keywords, t.Name)
+		}
+		r.Projects = append(r.Projects, proj)
+	}
+
+	// satu entry per tag: level dari override proficiency, keywords = tipe tag
+	for _, g := range groups {
+		for _, s := range g.Skills {
+			skill := Skill{Name: s.Name}
+			if s.Proficiency != nil {
+				skill.Level = *s.Proficiency
+			}
+			if g.Type != "" {
+				skill.Keywords = []string{g.Type}
+			}
+			r.Skills = append(r.Skills, skill)
+		}
+	}
+
+	return r
+}
@@ -0,0 +1,74 @@
+package jsonresume
+
+// Subset schema JSON Resume v1.0.0 (https://jsonresume.org/schema) yang kita pakai.
+// Field lain di dokumen import diabaikan.
+
+const SchemaURL = "https://raw.githubusercontent.com/jsonresume/resume-schema/v1.0.0/schema.json"
+
+type Resume struct {
+	Schema   string    `json:"$schema,omitempty"`
+	Basics   Basics    `json:"basics"`
+	Work     []Work    `json:"work" validate:"dive"`
+	Projects []Project `json:"projects" validate:"dive"`
+	Skills   []Skill   `json:"skills"`
+	Meta     *Meta     `json:"meta,omitempty"`
+}
+
+type Basics struct {
+	Name     string    `json:"name"`
+	Label    string    `json:"label,omitempty"`
+	Image    string    `json:"image,omitempty"`
+	Email    string    `json:"email,omitempty"`
+	Phone    string    `json:"phone,omitempty"`
+	URL      string    `json:"url,omitempty"`
+	Summary  string    `json:"summary,omitempty"`
+	Location *Location `json:"location,omitempty"`
+	Profiles []Profile `json:"profiles,omitempty"`
+}
+
+type Location struct {
+	City        string `json:"city,omitempty"`
+	CountryCode string `json:"countryCode,omitempty"`
+}
+
+type Profile struct {
+	Network  string `json:"network"`
+	Username string `json:"username,omitempty"`
+	URL      string `json:"url"`
+}
+
+// Tanggal: ISO 8601 "YYYY-MM-DD", "YYYY-MM" atau "YYYY"
+type Work struct {
+	Name       string   `json:"name" validate:"required"`
+	Position   string   `json:"position" validate:"required"`
+	Location   string   `json:"location,omitempty"`
+	URL        string   `json:"url,omitempty"`
+	StartDate  string   `json:"startDate" validate:"required"`
+	EndDate    string   `json:"endDate,omitempty"` // kosong = masih bekerja
+	Summary    string   `json:"summary,omitempty"`
+	Highlights []string `json:"highlights,omitempty"`
+}
+
+type Project struct {
+	Name        string   `json:"name" validate:"required"`
+	Description string   `json:"description,omitempty"`
+	Highlights  []string `json:"highlights,omitempty"`
+	Keywords    []string `json:"keywords,omitempty"`
+	StartDate   string   `json:"startDate,omitempty"`
+	EndDate     string   `json:"endDate,omitempty"`
+	URL         string   `json:"url,omitempty"`
+	Roles       []string `json:"roles,omitempty"`
+	Type        string   `json:"type,omitempty"`
+}
+
+type Skill struct {
+	Name     string   `json:"name"`
+	Level    string   `json:"level,omitempty"`
+	Keywords []string `json:"keywords,omitempty"`
+}
+
+type Meta struct {
+	Canonical    string `json:"canonical,omitempty"`
+	Version      string `json:"version,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+}
@@ -0,0 +1,155 @@
+package linkcheck
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/FauzanParanditha/portfolio-backend/internal/repository"
+	"github.com/rs/zerolog/log"
+)
+
+const userAgent = "portfolio-backend-linkcheck/1.0"
+
+type Config struct {
+	Interval    time.Duration
+	Timeout     time.Duration
+	Concurrency int
+}
+
+// Checker menjalankan pengecekan URL project secara berkala
+// dan menyimpan status, latency, dan waktu cek terakhir.
+type Checker struct {
+	repo   repository.LinkCheckRepository
+	client *http.Client
+	cfg    Config
+}
+
+func New(repo repository.LinkCheckRepository, cfg Config) *Checker {
+	if cfg.Interval <= 0 {
+		cfg.Interval = 6 * time.Hour
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 10 * time.Second
+	}
+	if cfg.Concurrency < 1 {
+		cfg.Concurrency = 1
+	}
+
+	return &Checker{
+		repo:   repo,
+		client: &http.Client{Timeout: cfg.Timeout},
+		cfg:    cfg,
+	}
+}
+
+// Run: cek sekali saat start, lalu ulangi setiap Interval sampai ctx selesai.
+func (c *Checker) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := c.RunOnce(ctx); err != nil {
+			log.Error().Err(err).Msg("link check run failed")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce mengecek semua URL project satu kali.
+func (c *Checker) RunOnce(ctx context.Context) error {
+	startedAt := time.Now()
+
+	targets, err := c.repo.ListTargets(ctx)
+	if err != nil {
+		return err
+	}
+
+	sem := make(chan struct{}, c.cfg.Concurrency)
+	var wg sync.WaitGroup
+
+	for i := range targets {
+		lc := &targets[i]
+
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+			return ctx.Err()
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			c.check(ctx, lc)
+
+			if err := c.repo.Upsert(ctx, lc); err != nil {
+				log.Error().Err(err).Str("url", lc.URL).Msg("failed to save link check")
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	// hanya URL yang sudah tidak ada di project yang dihapus; hasil lama untuk
+	// URL yang gagal disimpan di run ini tetap ada
+	if err := c.repo.DeleteMissing(ctx, targets); err != nil {
+		return err
+	}
+
+	log.Info().
+		Int("links", len(targets)).
+		Dur("duration", time.Since(startedAt)).
+		Msg("link check completed")
+
+	return nil
+}
+
+// check: coba HEAD dulu, fallback ke GET kalau server tidak mendukung HEAD.
+func (c *Checker) check(ctx context.Context, lc *models.LinkCheck) {
+	start := time.Now()
+
+	status, err := c.do(ctx, http.MethodHead, lc.URL)
+	if err != nil || status == http.StatusMethodNotAllowed || status == http.StatusNotImplemented || status == http.StatusForbidden {
+		status, err = c.do(ctx, http.MethodGet, lc.URL)
+	}
+
+	lc.LatencyMs = time.Since(start).Milliseconds()
+	lc.CheckedAt = time.Now()
+	lc.StatusCode = status
+	lc.Error = ""
+
+	if err != nil {
+		lc.Error = err.Error()
+	}
+	lc.IsBroken = err != nil || status >= 400
+}
+
+func (c *Checker) do(ctx context.Context, method, url string) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("User-Agent", userAgent)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	// cukup baca sedikit supaya koneksi bisa dipakai ulang
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	return resp.StatusCode, nil
+}
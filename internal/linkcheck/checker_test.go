@@ -0,0 +1,119 @@
+package linkcheck
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/FauzanParanditha/portfolio-backend/internal/repository"
+)
+
+func TestCheckFallsBackToGetWhenHeadNotAllowed(t *testing.T) {
+	var mu sync.Mutex
+	var methods []string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		methods = append(methods, r.Method)
+		mu.Unlock()
+
+		if r.Method == http.MethodHead {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	c := New(nil, Config{Timeout: time.Second})
+	lc := &models.LinkCheck{URL: srv.URL}
+	c.check(context.Background(), lc)
+
+	if lc.StatusCode != http.StatusOK || lc.IsBroken || lc.Error != "" {
+		t.Fatalf("got status=%d broken=%v error=%q, want 200 and not broken", lc.StatusCode, lc.IsBroken, lc.Error)
+	}
+	if len(methods) != 2 || methods[0] != http.MethodHead || methods[1] != http.MethodGet {
+		t.Fatalf("methods = %v, want [HEAD GET]", methods)
+	}
+}
+
+func TestCheckMarksTimeoutAsBroken(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	c := New(nil, Config{Timeout: 50 * time.Millisecond})
+	lc := &models.LinkCheck{URL: srv.URL}
+	c.check(context.Background(), lc)
+
+	if !lc.IsBroken || lc.Error == "" || lc.StatusCode != 0 {
+		t.Fatalf("got status=%d broken=%v error=%q, want broken with error", lc.StatusCode, lc.IsBroken, lc.Error)
+	}
+}
+
+// fakeRepo: LinkCheckRepository di memori
+type fakeRepo struct {
+	mu        sync.Mutex
+	targets   []models.LinkCheck
+	upsertErr error
+	upserted  []string
+	pruneWith []models.LinkCheck
+}
+
+func (f *fakeRepo) ListTargets(context.Context) ([]models.LinkCheck, error) {
+	return append([]models.LinkCheck{}, f.targets...), nil
+}
+
+func (f *fakeRepo) Upsert(_ context.Context, lc *models.LinkCheck) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.upserted = append(f.upserted, lc.URL)
+	return f.upsertErr
+}
+
+func (f *fakeRepo) DeleteMissing(_ context.Context, targets []models.LinkCheck) error {
+	f.pruneWith = targets
+	return nil
+}
+
+func (f *fakeRepo) List(context.Context, repository.LinkCheckListParams) ([]models.LinkCheck, error) {
+	return nil, nil
+}
+
+func TestRunOnceKeepsTargetsWhenUpsertFails(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+
+	repo := &fakeRepo{
+		targets: []models.LinkCheck{
+			{Kind: models.LinkKindDemo, URL: srv.URL + "/a"},
+			{Kind: models.LinkKindRepo, URL: srv.URL + "/b"},
+		},
+		upsertErr: errors.New("db down"),
+	}
+
+	c := New(repo, Config{Timeout: time.Second, Concurrency: 2})
+	if err := c.RunOnce(context.Background()); err != nil {
+		t.Fatalf("RunOnce: %v", err)
+	}
+
+	if len(repo.upserted) != 2 {
+		t.Fatalf("upserted %d links, want 2", len(repo.upserted))
+	}
+	// prune hanya berdasarkan daftar target, bukan hasil upsert
+	if len(repo.pruneWith) != 2 {
+		t.Fatalf("DeleteMissing got %d targets, want 2", len(repo.pruneWith))
+	}
+}
@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ProjectViewDaily: rollup view project per hari, per referrer host & kelas user-agent.
+// Tidak ada IP / cookie yang disimpan, unique visitor dihitung di memori
+// dengan hash ber-salt yang dirotasi setiap hari.
+type ProjectViewDaily struct {
+	ProjectID    uuid.UUID `gorm:"type:uuid;primaryKey" json:"projectId"`
+	Day          time.Time `gorm:"type:date;primaryKey" json:"day"`
+	ReferrerHost string    `gorm:"primaryKey" json:"referrerHost"`
+	UAClass      string    `gorm:"column:ua_class;primaryKey" json:"uaClass"`
+	Views        int64     `json:"views"`
+	Uniques      int64     `json:"uniques"`
+}
+
+func (ProjectViewDaily) TableName() string {
+	return "project_view_daily"
+}
+
+type ProjectViewStat struct {
+	ProjectID string `json:"projectId"`
+	Title     string `json:"title"`
+	Slug      string `json:"slug"`
+	Views     int64  `json:"views"`
+	Uniques   int64  `json:"uniques"`
+}
+
+type DailyViewStat struct {
+	Day     string `json:"day"` // "2006-01-02"
+	Views   int64  `json:"views"`
+	Uniques int64  `json:"uniques"`
+}
+
+type ViewBreakdownStat struct {
+	Key     string `json:"key"`
+	Views   int64  `json:"views"`
+	Uniques int64  `json:"uniques"`
+}
@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Award struct {
+	ID          uuid.UUID `gorm:"type:uuid;default:uuid_generate_v4();primaryKey" json:"id"`
+	Title       string    `json:"title"`
+	Issuer      string    `json:"issuer"`
+	AwardDate   time.Time `json:"awardDate"`
+	URL         string    `gorm:"column:url" json:"url"`
+	Description string    `json:"description"`
+	SortOrder   int       `json:"sortOrder"`
+
+	Tags []Tag `gorm:"many2many:award_tags;" json:"tags"`
+
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Certification struct {
+	ID            uuid.UUID  `gorm:"type:uuid;default:uuid_generate_v4();primaryKey" json:"id"`
+	Name          string     `json:"name"`
+	Issuer        string     `json:"issuer"`
+	IssueDate     time.Time  `json:"issueDate"`
+	ExpiryDate    *time.Time `json:"expiryDate"` // nil = tidak kedaluwarsa
+	CredentialID  string     `gorm:"column:credential_id" json:"credentialId"`
+	CredentialURL string     `gorm:"column:credential_url" json:"credentialUrl"`
+	Description   string     `json:"description"`
+	SortOrder     int        `json:"sortOrder"`
+
+	Tags []Tag `gorm:"many2many:certification_tags;" json:"tags"`
+
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// CertificationToday: tanggal hari ini (UTC) untuk cek expiry, dipakai juga oleh
+// filter expired=false di query supaya flag & filter selalu sepakat
+func CertificationToday(now time.Time) time.Time {
+	now = now.UTC()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// IsExpired: expiry_date sudah lewat (dibanding tanggal hari ini, UTC)
+func (c Certification) IsExpired(now time.Time) bool {
+	if c.ExpiryDate == nil {
+		return false
+	}
+	return c.ExpiryDate.Before(CertificationToday(now))
+}
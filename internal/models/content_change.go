@@ -0,0 +1,9 @@
+package models
+
+import "time"
+
+// ContentChange: waktu perubahan terakhir per topic konten (lihat package changes)
+type ContentChange struct {
+	Topic     string    `gorm:"primaryKey" json:"topic"`
+	ChangedAt time.Time `json:"changedAt"`
+}
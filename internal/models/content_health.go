@@ -0,0 +1,10 @@
+package models
+
+import "github.com/google/uuid"
+
+// ContentIssueRow: satu baris hasil lint rule (sebelum diberi severity / saran fix)
+type ContentIssueRow struct {
+	Rule     string
+	EntityID uuid.UUID
+	Label    string
+}
@@ -4,6 +4,7 @@ import "time"
 
 type DashboardOverviewResponse struct {
 	Projects struct {
+		// semua project; project tidak punya status draft / published
 		Total       int64 `json:"total"`
 		Featured    int64 `json:"featured"`
 		RecentCount int64 `json:"recentCount"`
@@ -21,8 +22,42 @@ type DashboardOverviewResponse struct {
 		RecentCount int64 `json:"recentCount"`
 	} `json:"contactMessages"`
 
+	Links struct {
+		Total  int64 `json:"total"`
+		Broken int64 `json:"broken"`
+	} `json:"links"`
+
+	Health struct {
+		Issues int64 `json:"issues"`
+	} `json:"health"`
+
 	System struct {
 		ServerTime time.Time `json:"serverTime"`
 		RecentDays int       `json:"recentDays"`
 	} `json:"system"`
-}
\ No newline at end of file
+}
+// DashboardCounts: hasil satu query agregat (COUNT ... FILTER) untuk overview
+type DashboardCounts struct {
+	ProjectsTotal    int64
+	ProjectsFeatured int64
+	ProjectsRecent   int64
+
+	ExperiencesTotal   int64
+	ExperiencesCurrent int64
+	ExperiencesRecent  int64
+
+	ContactTotal  int64
+	ContactUnread int64
+	ContactRecent int64
+
+	LinksTotal  int64
+	LinksBroken int64
+}
+
+// DashboardDailyStat: jumlah item yang dibuat per hari (timezone sesuai request)
+type DashboardDailyStat struct {
+	Day             string `json:"day"` // YYYY-MM-DD
+	Projects        int64  `json:"projects"`
+	Experiences     int64  `json:"experiences"`
+	ContactMessages int64  `json:"contactMessages"`
+}
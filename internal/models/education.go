@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Education struct {
+	ID           uuid.UUID  `gorm:"type:uuid;default:uuid_generate_v4();primaryKey" json:"id"`
+	Institution  string     `json:"institution"`
+	Degree       string     `json:"degree"`
+	FieldOfStudy string     `json:"fieldOfStudy"`
+	Location     string     `json:"location"`
+	StartDate    time.Time  `json:"startDate"`
+	EndDate      *time.Time `json:"endDate"`
+	IsCurrent    bool       `json:"isCurrent"`
+	Grade        string     `json:"grade"` // bebas: "3.8/4.0", "Cum Laude", dll
+	Description  string     `json:"description"`
+	SortOrder    int        `json:"sortOrder"`
+
+	Tags []Tag `gorm:"many2many:education_tags;" json:"tags"`
+
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
@@ -9,7 +9,7 @@ import (
 type Experience struct {
 	ID          uuid.UUID `gorm:"type:uuid;default:uuid_generate_v4();primaryKey" json:"id"`
 	Title       string    `json:"title"`
-	Company     string    `json:"company"`
+	Company     string    `json:"company"` // = Organization.Name kalau organisasi di-link
 	Location    string    `json:"location"`
 	StartDate   time.Time `json:"startDate"`
 	EndDate     *time.Time `json:"endDate"`
@@ -17,13 +17,28 @@ type Experience struct {
 	Description string    `json:"description"`
 	SortOrder   int       `json:"sortOrder"`
 
+	// full_time | part_time | contract | freelance | internship
+	EmploymentType string `gorm:"default:full_time" json:"employmentType"`
+
+	OrganizationID *uuid.UUID    `gorm:"type:uuid" json:"organizationId"`
+	Organization   *Organization `json:"organization"`
+
 	Highlights []ExperienceHighlight `gorm:"foreignKey:ExperienceID" json:"highlights"`
 	Tags       []Tag                 `gorm:"many2many:experience_tags;" json:"tags"`
+	Projects   []Project             `gorm:"many2many:experience_projects;" json:"projects"`
 
 	CreatedAt time.Time `json:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt"`
 }
 
+const (
+	EmploymentFullTime   = "full_time"
+	EmploymentPartTime   = "part_time"
+	EmploymentContract   = "contract"
+	EmploymentFreelance  = "freelance"
+	EmploymentInternship = "internship"
+)
+
 type ExperienceHighlight struct {
 	ID           uuid.UUID `gorm:"type:uuid;default:uuid_generate_v4();primaryKey" json:"id"`
 	ExperienceID uuid.UUID `gorm:"type:uuid" json:"experienceId"`
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Jenis URL yang dicek oleh link checker
+const (
+	LinkKindDemo       = "demo"
+	LinkKindRepo       = "repo"
+	LinkKindCover      = "cover"
+	LinkKindScreenshot = "screenshot"
+)
+
+type LinkCheck struct {
+	ID        uuid.UUID `gorm:"type:uuid;default:uuid_generate_v4();primaryKey" json:"id"`
+	ProjectID uuid.UUID `gorm:"type:uuid" json:"projectId"`
+	Kind      string    `json:"kind"`
+	URL       string    `json:"url"`
+
+	StatusCode int       `json:"statusCode"`
+	IsBroken   bool      `json:"isBroken"`
+	Error      string    `json:"error"`
+	LatencyMs  int64     `json:"latencyMs"`
+	CheckedAt  time.Time `json:"checkedAt"`
+
+	Project *Project `gorm:"foreignKey:ProjectID" json:"-"`
+
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Organization: employer (experience) atau client (project)
+type Organization struct {
+	ID         uuid.UUID `gorm:"type:uuid;default:uuid_generate_v4();primaryKey" json:"id"`
+	Name       string    `json:"name"`
+	Slug       string    `gorm:"uniqueIndex" json:"slug"`
+	LogoURL    string    `json:"logoUrl"`
+	WebsiteURL string    `json:"websiteUrl"`
+	Industry   string    `json:"industry"`
+	Location   string    `json:"location"`
+
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
@@ -0,0 +1,34 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	PostStatusDraft     = "draft"
+	PostStatusPublished = "published"
+)
+
+type Post struct {
+	ID    uuid.UUID `gorm:"type:uuid;default:uuid_generate_v4();primaryKey" json:"id"`
+	Title string    `json:"title"`
+	Slug  string    `gorm:"uniqueIndex" json:"slug"`
+
+	Excerpt       string `json:"excerpt"`
+	Body          string `json:"body"` // markdown
+	CoverImageURL string `json:"coverImageUrl"`
+
+	// draft | published. Post published dengan PublishedAt di masa depan = terjadwal
+	Status      string     `json:"status"`
+	PublishedAt *time.Time `json:"publishedAt"`
+	ReadingTime int        `json:"readingTime"` // menit, dihitung dari body
+
+	SEO SEO `gorm:"embedded" json:"seo"`
+
+	Tags []Tag `gorm:"many2many:post_tags;" json:"tags"`
+
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
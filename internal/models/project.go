@@ -37,6 +37,15 @@ type Project struct {
 	Features []ProjectFeature `gorm:"foreignKey:ProjectID" json:"features"`
 	Tags     []Tag            `gorm:"many2many:project_tags;" json:"tags"`
 
+	// organisasi client (opsional)
+	ClientID *uuid.UUID    `gorm:"type:uuid" json:"clientId"`
+	Client   *Organization `gorm:"foreignKey:ClientID" json:"client"`
+
+	// experience tempat project ini dikerjakan
+	Experiences []Experience `gorm:"many2many:experience_projects;" json:"experiences"`
+
+	SEO SEO `gorm:"embedded" json:"seo"`
+
 	IsFeatured bool      `json:"isFeatured"`
 	SortOrder  int       `json:"sortOrder"`
 	CreatedAt  time.Time `json:"createdAt"`
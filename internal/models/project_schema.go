@@ -0,0 +1,18 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+// ProjectSchema: JSON Schema untuk technicalDetails per kategori project
+type ProjectSchema struct {
+	ID          uuid.UUID      `gorm:"type:uuid;default:uuid_generate_v4();primaryKey" json:"id"`
+	Category    string         `gorm:"uniqueIndex" json:"category"`
+	Description string         `json:"description"`
+	Schema      datatypes.JSON `json:"schema"`
+	CreatedAt   time.Time      `json:"createdAt"`
+	UpdatedAt   time.Time      `json:"updatedAt"`
+}
@@ -0,0 +1,10 @@
+package models
+
+// SEO: override meta tag per konten (embedded di Project & Post).
+// nil = pakai field tampilan (Title / ShortDesc / CoverImageURL / URL default).
+type SEO struct {
+	MetaTitle       *string `gorm:"column:meta_title" json:"metaTitle"`
+	MetaDescription *string `gorm:"column:meta_description" json:"metaDescription"`
+	CanonicalURL    *string `gorm:"column:canonical_url" json:"canonicalUrl"`
+	OGImageURL      *string `gorm:"column:og_image_url" json:"ogImageUrl"`
+}
@@ -0,0 +1,9 @@
+package models
+
+import "time"
+
+// SitemapEntry: slug + waktu update terakhir konten publik
+type SitemapEntry struct {
+	Slug      string
+	UpdatedAt time.Time
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ProficiencyBeginner     = "beginner"
+	ProficiencyIntermediate = "intermediate"
+	ProficiencyAdvanced     = "advanced"
+	ProficiencyExpert       = "expert"
+)
+
+// SkillOverride: pengaturan manual skills matrix per tag
+type SkillOverride struct {
+	TagID       uuid.UUID `gorm:"type:uuid;primaryKey" json:"tagId"`
+	Proficiency *string   `json:"proficiency"`
+	SortOrder   *int      `json:"sortOrder"` // nil = urut otomatis (tahun pemakaian)
+	Hidden      bool      `json:"hidden"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// SkillPeriod: satu experience yang memakai tag tsb
+type SkillPeriod struct {
+	TagID     uuid.UUID
+	StartDate time.Time
+	EndDate   *time.Time
+	IsCurrent bool
+}
+
+// SkillProjectStat: jumlah project per tag + project terakhir
+type SkillProjectStat struct {
+	TagID         uuid.UUID
+	ProjectCount  int
+	LastProjectAt time.Time
+}
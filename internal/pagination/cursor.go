@@ -0,0 +1,76 @@
+package pagination
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+var secret []byte
+
+// Init set secret HMAC untuk tanda tangan cursor (dipanggil sekali saat start)
+func Init(s string) {
+	secret = []byte(s)
+}
+
+// Cursor menyimpan sort key item pertama/terakhir di halaman.
+// Untuk list yang tidak punya sort_order (contact), SortOrder selalu 0.
+type Cursor struct {
+	// List: identitas list (resource + filter) tempat cursor dibuat,
+	// supaya cursor tidak bisa dipakai di list lain
+	List string `json:"l"`
+
+	SortOrder int       `json:"s"`
+	CreatedAt time.Time `json:"c"`
+	ID        uuid.UUID `json:"i"`
+
+	// Backward: true untuk prevCursor (ambil halaman sebelum cursor)
+	Backward bool `json:"b,omitempty"`
+}
+
+// Encode: base64url(json) + "." + base64url(hmac-sha256)
+func Encode(c Cursor) string {
+	payload, _ := json.Marshal(c)
+
+	body := base64.RawURLEncoding.EncodeToString(payload)
+	return body + "." + base64.RawURLEncoding.EncodeToString(sign(body))
+}
+
+// Decode memvalidasi tanda tangan, parse cursor, dan memastikan cursor berasal dari list yang sama
+func Decode(list, s string) (*Cursor, error) {
+	body, sig, ok := strings.Cut(s, ".")
+	if !ok {
+		return nil, ErrInvalidCursor
+	}
+
+	got, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil || !hmac.Equal(got, sign(body)) {
+		return nil, ErrInvalidCursor
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(body)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var c Cursor
+	if err := json.Unmarshal(payload, &c); err != nil || c.List != list {
+		return nil, ErrInvalidCursor
+	}
+
+	return &c, nil
+}
+
+func sign(body string) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(body))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,72 @@
+package pagination
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	Init("test-secret")
+
+	want := Cursor{
+		List:      "projects|featured=true",
+		SortOrder: 3,
+		CreatedAt: time.Date(2026, 10, 19, 8, 30, 0, 0, time.UTC),
+		ID:        uuid.MustParse("6f1c1d1e-8f5a-4c3e-9a57-0f6d3b2f9c11"),
+		Backward:  true,
+	}
+
+	got, err := Decode(want.List, Encode(want))
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if got.List != want.List || got.SortOrder != want.SortOrder || !got.CreatedAt.Equal(want.CreatedAt) ||
+		got.ID != want.ID || got.Backward != want.Backward {
+		t.Fatalf("got %+v, want %+v", *got, want)
+	}
+}
+
+func TestCursorDecodeRejects(t *testing.T) {
+	Init("test-secret")
+
+	c := Cursor{List: "posts", CreatedAt: time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC), ID: uuid.New()}
+	valid := Encode(c)
+	body, sig, _ := strings.Cut(valid, ".")
+
+	// payload lain yang ditandatangani dengan secret berbeda
+	Init("other-secret")
+	foreign := Encode(c)
+	Init("test-secret")
+
+	// payload diubah (list lain) tapi tanda tangan lama dipakai
+	other := c
+	other.List = "admin-posts"
+	forged, _, _ := strings.Cut(Encode(other), ".")
+
+	tests := []struct {
+		name   string
+		list   string
+		cursor string
+	}{
+		{"empty", "posts", ""},
+		{"no signature", "posts", body},
+		{"bad base64 signature", "posts", body + ".!!!"},
+		{"truncated signature", "posts", body + "." + sig[:len(sig)-2]},
+		{"tampered payload", "admin-posts", forged + "." + sig},
+		{"signed with other secret", "posts", foreign},
+		{"different list", "admin-posts", valid},
+		{"garbage", "posts", "not-a-cursor.at-all"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Decode(tt.list, tt.cursor); !errors.Is(err, ErrInvalidCursor) {
+				t.Fatalf("Decode(%q) err = %v, want ErrInvalidCursor", tt.cursor, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package pagination
+
+import (
+	"gorm.io/gorm"
+)
+
+// Params dipakai bersama oleh semua ListParams repository.
+// Kalau Cursor diisi, Page diabaikan (keyset); kalau tidak, fallback ke OFFSET.
+type Params struct {
+	Page   int
+	Limit  int
+	Cursor *Cursor
+}
+
+type PageInfo struct {
+	Total   *int64 // nil kalau WithTotal = false
+	HasNext bool
+	HasPrev bool
+}
+
+// Order: urutan list.
+//
+//	withSortOrder = true  → sort_order ASC, created_at DESC, id DESC (projects)
+//	withSortOrder = false → created_at DESC, id DESC (tags, contact messages)
+//
+// Kalau backward, urutannya dibalik (hasil nanti dibalik lagi oleh Trim).
+func Order(q *gorm.DB, table string, withSortOrder, backward bool) *gorm.DB {
+	return order(q, table, "created_at", withSortOrder, backward)
+}
+
+func order(q *gorm.DB, table, timeCol string, withSortOrder, backward bool) *gorm.DB {
+	asc, desc := "ASC", "DESC"
+	if backward {
+		asc, desc = desc, asc
+	}
+
+	if withSortOrder {
+		q = q.Order(table + ".sort_order " + asc)
+	}
+
+	return q.
+		Order(table + "." + timeCol + " " + desc).
+		Order(table + ".id " + desc)
+}
+
+// Apply memasang order, kondisi keyset / offset, dan LIMIT limit+1
+// (1 row ekstra untuk tahu apakah masih ada halaman berikutnya).
+func Apply(q *gorm.DB, table string, withSortOrder bool, p Params) *gorm.DB {
+	return apply(q, table, "created_at", withSortOrder, p)
+}
+
+// ApplyByTime sama seperti Apply tanpa sort_order, tapi kolom waktunya bisa diganti
+// (mis. posts.published_at). Cursor.CreatedAt lalu berisi nilai kolom tsb.
+func ApplyByTime(q *gorm.DB, table, timeCol string, p Params) *gorm.DB {
+	return apply(q, table, timeCol, false, p)
+}
+
+// ApplyWithSortOrder: sort_order ASC, <timeCol> DESC, id DESC
+// (mis. experiences yang diurutkan per start_date).
+func ApplyWithSortOrder(q *gorm.DB, table, timeCol string, p Params) *gorm.DB {
+	return apply(q, table, timeCol, true, p)
+}
+
+func apply(q *gorm.DB, table, timeCol string, withSortOrder bool, p Params) *gorm.DB {
+	backward := p.Cursor != nil && p.Cursor.Backward
+
+	q = order(q, table, timeCol, withSortOrder, backward)
+
+	if p.Cursor != nil {
+		where, args := keysetWhere(table, timeCol, withSortOrder, *p.Cursor)
+		q = q.Where(where, args...)
+	} else if p.Page > 1 {
+		q = q.Offset((p.Page - 1) * p.Limit)
+	}
+
+	return q.Limit(p.Limit + 1)
+}
+
+// keysetWhere: item yang posisinya setelah cursor (atau sebelum, kalau Backward)
+func keysetWhere(table, timeCol string, withSortOrder bool, c Cursor) (string, []any) {
+	so := table + ".sort_order"
+	ca := table + "." + timeCol
+	id := table + ".id"
+
+	// "setelah" pada kolom DESC berarti "<", pada kolom ASC berarti ">"
+	afterDesc, afterAsc := "<", ">"
+	if c.Backward {
+		afterDesc, afterAsc = ">", "<"
+	}
+
+	if !withSortOrder {
+		return "((" + ca + ", " + id + ") " + afterDesc + " (?, ?))",
+			[]any{c.CreatedAt, c.ID}
+	}
+
+	return "((" + so + " " + afterAsc + " ?) OR " +
+			"(" + so + " = ? AND " + ca + " " + afterDesc + " ?) OR " +
+			"(" + so + " = ? AND " + ca + " = ? AND " + id + " " + afterDesc + " ?))",
+		[]any{
+			c.SortOrder,
+			c.SortOrder, c.CreatedAt,
+			c.SortOrder, c.CreatedAt, c.ID,
+		}
+}
+
+// Trim membuang row ekstra dari Apply, membalik hasil kalau backward,
+// dan menghitung HasNext / HasPrev.
+func Trim[T any](items []T, p Params) ([]T, PageInfo) {
+	var info PageInfo
+
+	extra := len(items) > p.Limit
+	if extra {
+		items = items[:p.Limit]
+	}
+
+	switch {
+	case p.Cursor != nil && p.Cursor.Backward:
+		for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+			items[i], items[j] = items[j], items[i]
+		}
+		info.HasPrev = extra
+		info.HasNext = true
+	case p.Cursor != nil:
+		info.HasNext = extra
+		info.HasPrev = true
+	default:
+		info.HasNext = extra
+		info.HasPrev = p.Page > 1
+	}
+
+	return items, info
+}
+
+// Links: nextCursor dari item terakhir, prevCursor dari item pertama
+func Links(list string, info PageInfo, first, last Cursor) (next, prev *string) {
+	first.List, last.List = list, list
+
+	if info.HasNext {
+		last.Backward = false
+		s := Encode(last)
+		next = &s
+	}
+	if info.HasPrev {
+		first.Backward = true
+		s := Encode(first)
+		prev = &s
+	}
+	return next, prev
+}
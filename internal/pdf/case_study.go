@@ -0,0 +1,288 @@
+package pdf
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/go-pdf/fpdf"
+)
+
+// ImageLoader: ambil bytes gambar + tipe fpdf ("JPG" / "PNG" / "GIF")
+type ImageLoader func(ctx context.Context, url string) ([]byte, string, error)
+
+var errUnsupportedImage = errors.New("unsupported image type")
+
+// maksimal redirect saat ambil gambar
+const maxImageRedirects = 3
+
+var errBlockedAddress = errors.New("image host resolves to a non-public address")
+
+// NewImageClient: http.Client untuk ambil gambar dari URL yang diisi admin.
+// Koneksi ke IP loopback / private / link-local / CGNAT / NAT64 dll. ditolak saat dial (setelah DNS resolve,
+// jadi tidak bisa diakali lewat DNS), redirect dibatasi & harus tetap http(s).
+func NewImageClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip, err := netip.ParseAddr(host)
+			if err != nil || !publicIP(ip) {
+				return errBlockedAddress
+			}
+			return nil
+		},
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			Proxy:               nil,
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxImageRedirects {
+				return fmt.Errorf("stopped after %d redirects", maxImageRedirects)
+			}
+			if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+				return fmt.Errorf("unsupported redirect scheme: %s", req.URL.Scheme)
+			}
+			return nil
+		},
+	}
+}
+
+// blockedPrefixes: range non-publik yang lolos dari IsGlobalUnicast / IsPrivate
+var blockedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),       // "this network"
+	netip.MustParsePrefix("100.64.0.0/10"),   // CGNAT, juga metadata endpoint sebagian cloud
+	netip.MustParsePrefix("192.0.0.0/24"),    // IETF protocol assignments
+	netip.MustParsePrefix("192.0.2.0/24"),    // TEST-NET-1
+	netip.MustParsePrefix("198.18.0.0/15"),   // benchmarking
+	netip.MustParsePrefix("198.51.100.0/24"), // TEST-NET-2
+	netip.MustParsePrefix("203.0.113.0/24"),  // TEST-NET-3
+	netip.MustParsePrefix("240.0.0.0/4"),     // reserved
+	netip.MustParsePrefix("64:ff9b::/96"),    // NAT64, bisa menunjuk IPv4 internal
+	netip.MustParsePrefix("64:ff9b:1::/48"),  // NAT64 lokal
+	netip.MustParsePrefix("2001:db8::/32"),   // dokumentasi
+}
+
+func publicIP(ip netip.Addr) bool {
+	// ::ffff:10.0.0.1 → 10.0.0.1
+	ip = ip.Unmap()
+
+	if !ip.IsGlobalUnicast() || ip.IsPrivate() {
+		return false
+	}
+	for _, p := range blockedPrefixes {
+		if p.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// HTTPImageLoader: fetch gambar lewat HTTP dengan batas ukuran.
+// Hanya http(s); tipe dideteksi dari isi, bukan dari Content-Type server.
+func HTTPImageLoader(client *http.Client, maxBytes int64) ImageLoader {
+	return func(ctx context.Context, url string) ([]byte, string, error) {
+		if !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://") {
+			return nil, "", fmt.Errorf("unsupported image url: %s", url)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return nil, "", err
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, "", err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return nil, "", fmt.Errorf("image fetch: status %d", resp.StatusCode)
+		}
+
+		b, err := io.ReadAll(io.LimitReader(resp.Body, maxBytes+1))
+		if err != nil {
+			return nil, "", err
+		}
+		if int64(len(b)) > maxBytes {
+			return nil, "", fmt.Errorf("image larger than %d bytes", maxBytes)
+		}
+
+		switch http.DetectContentType(b) {
+		case "image/jpeg":
+			return b, "JPG", nil
+		case "image/png":
+			return b, "PNG", nil
+		case "image/gif":
+			return b, "GIF", nil
+		}
+		return nil, "", errUnsupportedImage
+	}
+}
+
+// CaseStudy: challenge, solution, results, features & screenshots dari satu project.
+// load boleh nil → screenshot ditulis sebagai URL saja. Gambar yang gagal diambil
+// juga jatuh ke URL supaya PDF tetap jadi.
+func CaseStudy(ctx context.Context, opt Options, p models.Project, projectURL string, load ImageLoader) ([]byte, error) {
+	d := newDoc(opt)
+
+	d.title(p.Title)
+	meta := []string{}
+	for _, s := range []string{p.Category, p.Role, p.Timeline} {
+		if s != "" {
+			meta = append(meta, s)
+		}
+	}
+	d.subtitle(strings.Join(meta, " · "))
+	d.subtitle(projectURL)
+
+	d.paragraph(p.ShortDesc)
+
+	if len(p.Tags) > 0 {
+		names := make([]string, 0, len(p.Tags))
+		for _, t := range p.Tags {
+			names = append(names, t.Name)
+		}
+		d.labeled("Stack", strings.Join(names, ", "))
+	}
+	if p.RepoURL != nil && *p.RepoURL != "" {
+		d.labeled("Repository", *p.RepoURL)
+	}
+	if p.DemoURL != nil && *p.DemoURL != "" {
+		d.labeled("Demo", *p.DemoURL)
+	}
+
+	if strings.TrimSpace(p.LongDesc) != "" {
+		d.heading("Overview")
+		d.paragraph(p.LongDesc)
+	}
+	if strings.TrimSpace(p.Challenge) != "" {
+		d.heading("Challenge")
+		d.paragraph(p.Challenge)
+	}
+	if strings.TrimSpace(p.Solution) != "" {
+		d.heading("Solution")
+		d.paragraph(p.Solution)
+	}
+	if len(p.Results) > 0 {
+		d.heading("Results")
+		d.bullets(p.Results)
+	}
+
+	if len(p.Features) > 0 {
+		features := append([]models.ProjectFeature(nil), p.Features...)
+		sort.SliceStable(features, func(i, j int) bool { return features[i].SortOrder < features[j].SortOrder })
+
+		items := make([]string, 0, len(features))
+		for _, f := range features {
+			items = append(items, f.Text)
+		}
+		d.heading("Key Features")
+		d.bullets(items)
+	}
+
+	if details := technicalDetails(p); len(details) > 0 {
+		d.heading("Technical Details")
+		for _, kv := range details {
+			d.labeled(kv[0], kv[1])
+		}
+	}
+
+	if len(p.Screenshots) > 0 {
+		shots := append([]models.ProjectScreenshot(nil), p.Screenshots...)
+		sort.SliceStable(shots, func(i, j int) bool { return shots[i].SortOrder < shots[j].SortOrder })
+
+		d.heading("Screenshots")
+		for i, s := range shots {
+			d.screenshot(ctx, fmt.Sprintf("shot-%d", i), s.ImageURL, load)
+		}
+	}
+
+	return d.output()
+}
+
+// screenshot: gambar selebar konten (maks 60% tinggi halaman), fallback ke URL
+func (d *doc) screenshot(ctx context.Context, name, url string, load ImageLoader) {
+	if load != nil {
+		if b, typ, err := load(ctx, url); err == nil {
+			info := d.f.RegisterImageOptionsReader(name, fpdf.ImageOptions{ImageType: typ, ReadDpi: true}, bytes.NewReader(b))
+			if d.f.Ok() && info != nil && info.Width() > 0 {
+				_, pageH := d.f.GetPageSize()
+				w := d.w
+				h := w * info.Height() / info.Width()
+				if maxH := pageH * 0.6; h > maxH {
+					h = maxH
+					w = h * info.Width() / info.Height()
+				}
+
+				d.f.ImageOptions(name, d.t.Margin, d.f.GetY(), w, h, true, fpdf.ImageOptions{ImageType: typ}, 0, "")
+				d.f.Ln(3)
+				return
+			}
+			// gambar rusak: reset error fpdf supaya dokumen tetap bisa ditulis
+			d.f.ClearError()
+		}
+	}
+
+	d.muted()
+	d.f.SetFont(d.t.Font, "I", d.t.BodySize-1)
+	d.f.MultiCell(d.w, d.t.LineHeight, d.tr(url), "", "L", false)
+}
+
+// technicalDetails: JSON object flat → pasangan key/value (urut key).
+// Array digabung koma, nilai lain ditulis sebagai JSON ringkas; JSON non-object diabaikan.
+func technicalDetails(p models.Project) [][2]string {
+	if len(p.TechnicalDetails) == 0 {
+		return nil
+	}
+
+	var m map[string]any
+	if err := json.Unmarshal(p.TechnicalDetails, &m); err != nil {
+		return nil
+	}
+
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	out := make([][2]string, 0, len(keys))
+	for _, k := range keys {
+		var v string
+		switch x := m[k].(type) {
+		case string:
+			v = x
+		case []any:
+			parts := make([]string, 0, len(x))
+			for _, it := range x {
+				parts = append(parts, fmt.Sprint(it))
+			}
+			v = strings.Join(parts, ", ")
+		default:
+			b, _ := json.Marshal(x)
+			v = string(b)
+		}
+		out = append(out, [2]string{k, v})
+	}
+	return out
+}
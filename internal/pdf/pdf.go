@@ -0,0 +1,269 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-pdf/fpdf"
+)
+
+const ContentType = "application/pdf"
+
+// Ukuran kertas yang didukung (key lowercase dari config / ?paper=)
+var papers = map[string]string{
+	"a4":     "A4",
+	"letter": "Letter",
+	"legal":  "Legal",
+}
+
+// Template: gaya tampilan dokumen. Font hanya core font PDF (tanpa file font eksternal).
+type Template struct {
+	Name        string
+	Font        string  // Helvetica / Times / Courier
+	Margin      float64 // mm
+	TitleSize   float64
+	HeadingSize float64
+	BodySize    float64
+	LineHeight  float64 // mm per baris body
+	Accent      [3]int  // RGB heading & garis
+	Muted       [3]int  // RGB teks sekunder (tanggal, subtitle)
+}
+
+var templates = map[string]Template{
+	"classic": {
+		Name:        "classic",
+		Font:        "Helvetica",
+		Margin:      18,
+		TitleSize:   22,
+		HeadingSize: 13,
+		BodySize:    10,
+		LineHeight:  5,
+		Accent:      [3]int{31, 78, 121},
+		Muted:       [3]int{110, 110, 110},
+	},
+	"compact": {
+		Name:        "compact",
+		Font:        "Helvetica",
+		Margin:      12,
+		TitleSize:   18,
+		HeadingSize: 11,
+		BodySize:    9,
+		LineHeight:  4.2,
+		Accent:      [3]int{40, 40, 40},
+		Muted:       [3]int{120, 120, 120},
+	},
+	"serif": {
+		Name:        "serif",
+		Font:        "Times",
+		Margin:      20,
+		TitleSize:   24,
+		HeadingSize: 14,
+		BodySize:    11,
+		LineHeight:  5.4,
+		Accent:      [3]int{120, 30, 30},
+		Muted:       [3]int{100, 100, 100},
+	},
+}
+
+// LookupTemplate: nama case-insensitive
+func LookupTemplate(name string) (Template, bool) {
+	t, ok := templates[strings.ToLower(strings.TrimSpace(name))]
+	return t, ok
+}
+
+// TemplateNames: untuk pesan error / dokumentasi
+func TemplateNames() []string {
+	names := make([]string, 0, len(templates))
+	for n := range templates {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NormalizePaper: "a4" / "A4" → "A4"; ok = false kalau tidak didukung
+func NormalizePaper(s string) (string, bool) {
+	p, ok := papers[strings.ToLower(strings.TrimSpace(s))]
+	return p, ok
+}
+
+// Options: dipakai oleh semua dokumen
+type Options struct {
+	Template Template
+	Paper    string // hasil NormalizePaper
+	Title    string
+	Author   string
+	Modified time.Time // creation / modification date di metadata → output deterministik
+}
+
+// doc: helper layout di atas fpdf
+type doc struct {
+	f   *fpdf.Fpdf
+	t   Template
+	tr  func(string) string // UTF-8 → cp1252 (core font)
+	w   float64             // lebar area konten
+	opt Options
+}
+
+func newDoc(opt Options) *doc {
+	f := fpdf.NewCustom(&fpdf.InitType{
+		OrientationStr: "P",
+		UnitStr:        "mm",
+		SizeStr:        opt.Paper,
+	})
+
+	t := opt.Template
+	f.SetMargins(t.Margin, t.Margin, t.Margin)
+	f.SetAutoPageBreak(true, t.Margin)
+	f.SetCatalogSort(true)
+	f.SetCreationDate(opt.Modified)
+	f.SetModificationDate(opt.Modified)
+	f.SetTitle(opt.Title, true)
+	f.SetAuthor(opt.Author, true)
+	f.SetCreator("portfolio-backend", true)
+	f.AliasNbPages("{nb}")
+
+	d := &doc{
+		f:   f,
+		t:   t,
+		tr:  f.UnicodeTranslatorFromDescriptor(""),
+		opt: opt,
+	}
+
+	pageW, _ := f.GetPageSize()
+	d.w = pageW - 2*t.Margin
+
+	f.SetFooterFunc(func() {
+		f.SetY(-t.Margin + 4)
+		d.muted()
+		f.SetFont(t.Font, "", t.BodySize-2)
+		f.CellFormat(0, 4, fmt.Sprintf("%d / {nb}", f.PageNo()), "", 0, "R", false, 0, "")
+	})
+
+	f.AddPage()
+	return d
+}
+
+func (d *doc) output() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := d.f.Output(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (d *doc) text() {
+	d.f.SetTextColor(20, 20, 20)
+}
+
+func (d *doc) muted() {
+	d.f.SetTextColor(d.t.Muted[0], d.t.Muted[1], d.t.Muted[2])
+}
+
+func (d *doc) accent() {
+	d.f.SetTextColor(d.t.Accent[0], d.t.Accent[1], d.t.Accent[2])
+}
+
+// title: judul besar di atas halaman
+func (d *doc) title(s string) {
+	d.accent()
+	d.f.SetFont(d.t.Font, "B", d.t.TitleSize)
+	d.f.MultiCell(d.w, d.t.TitleSize*0.45, d.tr(s), "", "L", false)
+}
+
+// subtitle: satu baris muted di bawah judul (label, kontak, kategori)
+func (d *doc) subtitle(s string) {
+	if s == "" {
+		return
+	}
+	d.muted()
+	d.f.SetFont(d.t.Font, "", d.t.BodySize)
+	d.f.MultiCell(d.w, d.t.LineHeight, d.tr(s), "", "L", false)
+}
+
+// heading: judul section + garis accent
+func (d *doc) heading(s string) {
+	d.f.Ln(d.t.LineHeight)
+	d.accent()
+	d.f.SetFont(d.t.Font, "B", d.t.HeadingSize)
+	d.f.CellFormat(d.w, d.t.HeadingSize*0.5, d.tr(s), "", 1, "L", false, 0, "")
+
+	y := d.f.GetY() + 0.5
+	d.f.SetDrawColor(d.t.Accent[0], d.t.Accent[1], d.t.Accent[2])
+	d.f.SetLineWidth(0.3)
+	d.f.Line(d.t.Margin, y, d.t.Margin+d.w, y)
+	d.f.Ln(2)
+}
+
+// entry: baris judul item (bold) dengan teks kanan muted (mis. tanggal)
+func (d *doc) entry(left, right string) {
+	d.f.SetFont(d.t.Font, "", d.t.BodySize-1)
+	rightW := 0.0
+	if right != "" {
+		rightW = d.f.GetStringWidth(d.tr(right)) + 2
+	}
+
+	d.text()
+	d.f.SetFont(d.t.Font, "B", d.t.BodySize+1)
+	y := d.f.GetY()
+	d.f.MultiCell(d.w-rightW, d.t.LineHeight+0.5, d.tr(left), "", "L", false)
+	after := d.f.GetY()
+
+	if right != "" {
+		// kalau judul pindah halaman, tanggal ikut di baris pertama halaman baru
+		if after < y {
+			y = d.t.Margin
+		}
+		d.muted()
+		d.f.SetFont(d.t.Font, "", d.t.BodySize-1)
+		d.f.SetXY(d.t.Margin+d.w-rightW, y)
+		d.f.CellFormat(rightW, d.t.LineHeight+0.5, d.tr(right), "", 0, "R", false, 0, "")
+		d.f.SetXY(d.t.Margin, after)
+	}
+}
+
+// paragraph: teks body; baris kosong dipertahankan sebagai jeda paragraf
+func (d *doc) paragraph(s string) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return
+	}
+	d.text()
+	d.f.SetFont(d.t.Font, "", d.t.BodySize)
+	d.f.MultiCell(d.w, d.t.LineHeight, d.tr(s), "", "L", false)
+	d.f.Ln(1)
+}
+
+// bullets: list dengan indent
+func (d *doc) bullets(items []string) {
+	indent := 5.0
+	d.text()
+	d.f.SetFont(d.t.Font, "", d.t.BodySize)
+	for _, it := range items {
+		it = strings.TrimSpace(it)
+		if it == "" {
+			continue
+		}
+		d.f.SetX(d.t.Margin)
+		d.f.CellFormat(indent, d.t.LineHeight, d.tr("•"), "", 0, "C", false, 0, "")
+		d.f.MultiCell(d.w-indent, d.t.LineHeight, d.tr(it), "", "L", false)
+	}
+	d.f.Ln(1)
+}
+
+// labeled: "Label: isi" dengan label bold
+func (d *doc) labeled(label, value string) {
+	if value == "" {
+		return
+	}
+	d.text()
+	d.f.SetFont(d.t.Font, "B", d.t.BodySize)
+	lw := d.f.GetStringWidth(d.tr(label+": ")) + 1
+	d.f.SetX(d.t.Margin)
+	d.f.CellFormat(lw, d.t.LineHeight, d.tr(label+":"), "", 0, "L", false, 0, "")
+	d.f.SetFont(d.t.Font, "", d.t.BodySize)
+	d.f.MultiCell(d.w-lw, d.t.LineHeight, d.tr(value), "", "L", false)
+}
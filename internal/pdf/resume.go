@@ -0,0 +1,91 @@
+package pdf
+
+import (
+	"strings"
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/jsonresume"
+	"github.com/FauzanParanditha/portfolio-backend/internal/skills"
+)
+
+// Resume: CV dari basics + work (format JSON Resume) + skills matrix per tipe tag
+func Resume(opt Options, basics jsonresume.Basics, work []jsonresume.Work, groups []skills.Group) ([]byte, error) {
+	d := newDoc(opt)
+
+	d.title(basics.Name)
+	d.subtitle(basics.Label)
+	d.subtitle(contactLine(basics))
+	d.paragraph(basics.Summary)
+
+	if len(work) > 0 {
+		d.heading("Experience")
+		for _, w := range work {
+			d.entry(w.Position+" - "+w.Name, dateRange(w.StartDate, w.EndDate))
+			if w.Location != "" {
+				d.subtitle(w.Location)
+			}
+			d.paragraph(w.Summary)
+			d.bullets(w.Highlights)
+		}
+	}
+
+	if len(groups) > 0 {
+		d.heading("Skills")
+		for _, g := range groups {
+			names := make([]string, 0, len(g.Skills))
+			for _, s := range g.Skills {
+				n := s.Name
+				if s.Proficiency != nil {
+					n += " (" + *s.Proficiency + ")"
+				}
+				names = append(names, n)
+			}
+			label := g.Type
+			if label == "" {
+				label = "Other"
+			}
+			d.labeled(strings.ToUpper(label[:1])+label[1:], strings.Join(names, ", "))
+		}
+	}
+
+	return d.output()
+}
+
+// contactLine: email · phone · kota · url · profile dalam satu baris
+func contactLine(b jsonresume.Basics) string {
+	parts := []string{}
+	for _, s := range []string{b.Email, b.Phone} {
+		if s != "" {
+			parts = append(parts, s)
+		}
+	}
+	if b.Location != nil {
+		loc := strings.Trim(b.Location.City+", "+b.Location.CountryCode, ", ")
+		if loc != "" {
+			parts = append(parts, loc)
+		}
+	}
+	if b.URL != "" {
+		parts = append(parts, b.URL)
+	}
+	for _, p := range b.Profiles {
+		parts = append(parts, p.URL)
+	}
+	return strings.Join(parts, " · ")
+}
+
+// dateRange: "Jan 2021 - Present"; input "YYYY-MM-DD"
+func dateRange(start, end string) string {
+	if end == "" {
+		return monthYear(start) + " - Present"
+	}
+	return monthYear(start) + " - " + monthYear(end)
+}
+
+func monthYear(s string) string {
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		return s
+	}
+	return t.Format("Jan 2006")
+}
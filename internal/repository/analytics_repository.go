@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type AnalyticsRepository interface {
+	// IncrementDaily: tambahkan views/uniques ke rollup harian (upsert)
+	IncrementDaily(ctx context.Context, rows []models.ProjectViewDaily) error
+	TopProjects(ctx context.Context, since time.Time, limit int) ([]models.ProjectViewStat, error)
+	ProjectDaily(ctx context.Context, projectID uuid.UUID, since time.Time) ([]models.DailyViewStat, error)
+	ProjectBreakdown(ctx context.Context, projectID uuid.UUID, since time.Time, column string) ([]models.ViewBreakdownStat, error)
+}
+
+type analyticsRepository struct {
+	db *gorm.DB
+}
+
+func NewAnalyticsRepository(db *gorm.DB) AnalyticsRepository {
+	return &analyticsRepository{db: db}
+}
+
+func (r *analyticsRepository) IncrementDaily(ctx context.Context, rows []models.ProjectViewDaily) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{
+				{Name: "project_id"}, {Name: "day"}, {Name: "referrer_host"}, {Name: "ua_class"},
+			},
+			DoUpdates: clause.Assignments(map[string]any{
+				"views":   gorm.Expr("project_view_daily.views + EXCLUDED.views"),
+				"uniques": gorm.Expr("project_view_daily.uniques + EXCLUDED.uniques"),
+			}),
+		}).
+		Create(&rows).Error
+}
+
+func (r *analyticsRepository) TopProjects(ctx context.Context, since time.Time, limit int) ([]models.ProjectViewStat, error) {
+	var stats []models.ProjectViewStat
+
+	err := r.db.WithContext(ctx).
+		Table("project_view_daily AS v").
+		Select("p.id AS project_id, p.title, p.slug, SUM(v.views) AS views, SUM(v.uniques) AS uniques").
+		Joins("JOIN projects p ON p.id = v.project_id").
+		Where("v.day >= ?", since.Format("2006-01-02")).
+		Group("p.id, p.title, p.slug").
+		Order("views DESC").
+		Limit(limit).
+		Scan(&stats).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+func (r *analyticsRepository) ProjectDaily(ctx context.Context, projectID uuid.UUID, since time.Time) ([]models.DailyViewStat, error) {
+	var stats []models.DailyViewStat
+
+	err := r.db.WithContext(ctx).
+		Model(&models.ProjectViewDaily{}).
+		Select("to_char(day, 'YYYY-MM-DD') AS day, SUM(views) AS views, SUM(uniques) AS uniques").
+		Where("project_id = ? AND day >= ?", projectID, since.Format("2006-01-02")).
+		Group("day").
+		Order("day ASC").
+		Scan(&stats).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// ProjectBreakdown: total per referrer_host atau ua_class
+func (r *analyticsRepository) ProjectBreakdown(ctx context.Context, projectID uuid.UUID, since time.Time, column string) ([]models.ViewBreakdownStat, error) {
+	if column != "referrer_host" && column != "ua_class" {
+		column = "referrer_host"
+	}
+
+	var stats []models.ViewBreakdownStat
+
+	err := r.db.WithContext(ctx).
+		Model(&models.ProjectViewDaily{}).
+		Select(column+" AS key, SUM(views) AS views, SUM(uniques) AS uniques").
+		Where("project_id = ? AND day >= ?", projectID, since.Format("2006-01-02")).
+		Group(column).
+		Order("views DESC").
+		Scan(&stats).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
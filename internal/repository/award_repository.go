@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"strings"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"gorm.io/gorm"
+)
+
+type AwardListParams struct {
+	Tag string // nama tag (case-insensitive)
+}
+
+type AwardRepository interface {
+	ListPublic(ctx context.Context, params AwardListParams) ([]models.Award, error)
+}
+
+type awardRepository struct {
+	db *gorm.DB
+}
+
+func NewAwardRepository(db *gorm.DB) AwardRepository {
+	return &awardRepository{db: db}
+}
+
+func (r *awardRepository) ListPublic(ctx context.Context, params AwardListParams) ([]models.Award, error) {
+	var items []models.Award
+
+	q := r.db.WithContext(ctx).Model(&models.Award{})
+
+	if params.Tag != "" {
+		q = q.Where(
+			"EXISTS (SELECT 1 FROM award_tags aw JOIN tags t ON t.id = aw.tag_id WHERE aw.award_id = awards.id AND LOWER(t.name) = ?)",
+			strings.ToLower(params.Tag),
+		)
+	}
+
+	if err := q.
+		Preload("Tags").
+		Order("awards.sort_order ASC").
+		Order("awards.award_date DESC").
+		Find(&items).Error; err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
@@ -0,0 +1,264 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/cache"
+	"github.com/FauzanParanditha/portfolio-backend/internal/changes"
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/FauzanParanditha/portfolio-backend/internal/pagination"
+)
+
+// Prefix key cache per kelompok konten, dipakai untuk invalidasi
+const (
+	cachePrefixProjects    = "projects:"
+	cachePrefixExperiences = "experiences:"
+	cachePrefixPosts       = "posts:"
+)
+
+// CacheInvalidator: callback untuk changes.Subscribe.
+// Perubahan tag ikut membuang semua cache (tag di-preload di project, experience & post).
+// Project & experience saling di-preload (experience_projects), jadi dibuang berdua.
+func CacheInvalidator(c *cache.Cache) func(changes.Topic) {
+	return func(t changes.Topic) {
+		switch t {
+		case changes.Projects, changes.Experiences:
+			c.DeletePrefix(cachePrefixProjects)
+			c.DeletePrefix(cachePrefixExperiences)
+		case changes.Posts:
+			c.DeletePrefix(cachePrefixPosts)
+		case changes.Education, changes.Certifications, changes.Awards:
+			// belum ada cache repository, tidak perlu dibuang
+		default:
+			c.Clear()
+		}
+	}
+}
+
+// cacheKey: prefix + JSON dari argumen (urutan kolom projection dinormalisasi)
+func cacheKey(prefix string, args ...any) string {
+	for i, a := range args {
+		switch v := a.(type) {
+		case Projection:
+			args[i] = normalizeProjection(v)
+		case ProjectListParams:
+			v.Projection = normalizeProjection(v.Projection)
+			args[i] = v
+		case ExperienceListParams:
+			v.Projection = normalizeProjection(v.Projection)
+			args[i] = v
+		}
+	}
+
+	b, _ := json.Marshal(args)
+	return prefix + string(b)
+}
+
+func normalizeProjection(p Projection) Projection {
+	cols := append([]string(nil), p.Columns...)
+	sort.Strings(cols)
+	p.Columns = cols
+	return p
+}
+
+// batas waktu query yang mengisi cache
+const cacheLoadTimeout = 5 * time.Second
+
+// loadContext: load cache dipakai bersama semua request yang menunggu key yang sama
+// (singleflight), jadi tidak boleh ikut batal kalau request pertama dibatalkan.
+func loadContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.WithoutCancel(ctx), cacheLoadTimeout)
+}
+
+// fetch: cache.Fetch + deep copy hasilnya, supaya caller bebas mengubah
+// slice / pointer tanpa merusak isi cache
+func fetch[T any](c *cache.Cache, ctx context.Context, key string, load func(ctx context.Context) (T, error)) (T, error) {
+	v, err := cache.Fetch(c, key, func() (T, error) {
+		ctx, cancel := loadContext(ctx)
+		defer cancel()
+		return load(ctx)
+	})
+	if err != nil {
+		return v, err
+	}
+	return cloneValue(reflect.ValueOf(&v)).Elem().Interface().(T), nil
+}
+
+// cloneValue: deep copy pointer, slice, map & field struct yang bisa di-set
+// (karena itu field di *Result / projectNeighbors sengaja exported);
+// field unexported seperti di time.Time ikut tercopy by value
+func cloneValue(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Elem().Type())
+		c.Elem().Set(cloneValue(v.Elem()))
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(cloneValue(v.Index(i)))
+		}
+		return c
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			c.SetMapIndex(iter.Key(), cloneValue(iter.Value()))
+		}
+		return c
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type()).Elem()
+		c.Set(cloneValue(v.Elem()))
+		return c
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := 0; i < c.NumField(); i++ {
+			if f := c.Field(i); f.CanSet() {
+				f.Set(cloneValue(v.Field(i)))
+			}
+		}
+		return c
+	}
+	return v
+}
+
+// ---------------------------------------------------------
+// Project
+// ---------------------------------------------------------
+
+type cachedProjectRepository struct {
+	inner ProjectRepository
+	cache *cache.Cache
+}
+
+// NewCachedProjectRepository membungkus repo dengan cache. Kalau c nil, repo asli dikembalikan.
+func NewCachedProjectRepository(inner ProjectRepository, c *cache.Cache) ProjectRepository {
+	if c == nil {
+		return inner
+	}
+	return &cachedProjectRepository{inner: inner, cache: c}
+}
+
+type projectListResult struct {
+	Items []models.Project
+	Info  pagination.PageInfo
+}
+
+type projectNeighbors struct {
+	Prev, Next *models.Project
+}
+
+func (r *cachedProjectRepository) ListPublic(ctx context.Context, params ProjectListParams) ([]models.Project, pagination.PageInfo, error) {
+	res, err := fetch(r.cache, ctx, cacheKey(cachePrefixProjects+"list:", params), func(ctx context.Context) (projectListResult, error) {
+		items, info, err := r.inner.ListPublic(ctx, params)
+		return projectListResult{Items: items, Info: info}, err
+	})
+	return res.Items, res.Info, err
+}
+
+func (r *cachedProjectRepository) GetBySlug(ctx context.Context, slug string, proj Projection) (*models.Project, error) {
+	return fetch(r.cache, ctx, cacheKey(cachePrefixProjects+"slug:", slug, proj), func(ctx context.Context) (*models.Project, error) {
+		return r.inner.GetBySlug(ctx, slug, proj)
+	})
+}
+
+func (r *cachedProjectRepository) GetNeighbors(ctx context.Context, p *models.Project, sameCategory bool) (prev, next *models.Project, err error) {
+	key := cachePrefixProjects + "neighbors:" + p.ID.String() + ":" + strconv.FormatBool(sameCategory)
+
+	res, err := fetch(r.cache, ctx, key, func(ctx context.Context) (projectNeighbors, error) {
+		prev, next, err := r.inner.GetNeighbors(ctx, p, sameCategory)
+		return projectNeighbors{Prev: prev, Next: next}, err
+	})
+	return res.Prev, res.Next, err
+}
+
+func (r *cachedProjectRepository) ListRecent(ctx context.Context, tag string, limit int) ([]models.Project, error) {
+	return fetch(r.cache, ctx, cacheKey(cachePrefixProjects+"recent:", tag, limit), func(ctx context.Context) ([]models.Project, error) {
+		return r.inner.ListRecent(ctx, tag, limit)
+	})
+}
+
+// ---------------------------------------------------------
+// Experience
+// ---------------------------------------------------------
+
+type cachedExperienceRepository struct {
+	inner ExperienceRepository
+	cache *cache.Cache
+}
+
+// NewCachedExperienceRepository membungkus repo dengan cache. Kalau c nil, repo asli dikembalikan.
+func NewCachedExperienceRepository(inner ExperienceRepository, c *cache.Cache) ExperienceRepository {
+	if c == nil {
+		return inner
+	}
+	return &cachedExperienceRepository{inner: inner, cache: c}
+}
+
+type experienceListResult struct {
+	Items []models.Experience
+	Info  pagination.PageInfo
+}
+
+func (r *cachedExperienceRepository) ListPublic(ctx context.Context, params ExperienceListParams) ([]models.Experience, pagination.PageInfo, error) {
+	res, err := fetch(r.cache, ctx, cacheKey(cachePrefixExperiences+"list:", params), func(ctx context.Context) (experienceListResult, error) {
+		items, info, err := r.inner.ListPublic(ctx, params)
+		return experienceListResult{Items: items, Info: info}, err
+	})
+	return res.Items, res.Info, err
+}
+
+// ---------------------------------------------------------
+// Post
+// ---------------------------------------------------------
+
+type cachedPostRepository struct {
+	inner PostRepository
+	cache *cache.Cache
+}
+
+// NewCachedPostRepository membungkus repo dengan cache. Kalau c nil, repo asli dikembalikan.
+// Catatan: post terjadwal baru muncul paling lambat setelah TTL cache habis.
+func NewCachedPostRepository(inner PostRepository, c *cache.Cache) PostRepository {
+	if c == nil {
+		return inner
+	}
+	return &cachedPostRepository{inner: inner, cache: c}
+}
+
+type postListResult struct {
+	Items []models.Post
+	Info  pagination.PageInfo
+}
+
+func (r *cachedPostRepository) ListPublic(ctx context.Context, params PostListParams) ([]models.Post, pagination.PageInfo, error) {
+	res, err := fetch(r.cache, ctx, cacheKey(cachePrefixPosts+"list:", params), func(ctx context.Context) (postListResult, error) {
+		items, info, err := r.inner.ListPublic(ctx, params)
+		return postListResult{Items: items, Info: info}, err
+	})
+	return res.Items, res.Info, err
+}
+
+func (r *cachedPostRepository) GetBySlug(ctx context.Context, slug string) (*models.Post, error) {
+	return fetch(r.cache, ctx, cacheKey(cachePrefixPosts+"slug:", slug), func(ctx context.Context) (*models.Post, error) {
+		return r.inner.GetBySlug(ctx, slug)
+	})
+}
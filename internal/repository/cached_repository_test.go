@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/cache"
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/FauzanParanditha/portfolio-backend/internal/pagination"
+	"github.com/google/uuid"
+)
+
+// fakeProjectRepo: ProjectRepository di memori, hanya method yang dites
+type fakeProjectRepo struct {
+	ProjectRepository
+
+	project models.Project
+
+	// state ctx saat load jalan
+	loadErr      error
+	loadDeadline bool
+}
+
+func (f *fakeProjectRepo) GetBySlug(ctx context.Context, _ string, _ Projection) (*models.Project, error) {
+	f.loadErr = ctx.Err()
+	_, f.loadDeadline = ctx.Deadline()
+	p := f.project
+	return &p, nil
+}
+
+func (f *fakeProjectRepo) ListPublic(context.Context, ProjectListParams) ([]models.Project, pagination.PageInfo, error) {
+	return []models.Project{f.project}, pagination.PageInfo{}, nil
+}
+
+func newFakeProject() models.Project {
+	demo := "https://demo.example.com"
+	return models.Project{
+		ID:          uuid.New(),
+		Title:       "Portfolio",
+		Slug:        "portfolio",
+		DemoURL:     &demo,
+		Results:     []string{"fast"},
+		Tags:        []models.Tag{{Name: "go"}},
+		Features:    []models.ProjectFeature{{Text: "cursor pagination"}},
+		Screenshots: []models.ProjectScreenshot{{ImageURL: "https://img.example.com/1.png"}},
+		CreatedAt:   time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestCachedProjectResultsAreIsolated(t *testing.T) {
+	inner := &fakeProjectRepo{project: newFakeProject()}
+	repo := NewCachedProjectRepository(inner, cache.New(cache.Config{TTL: time.Minute, MaxEntries: 10}))
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		mutate func(t *testing.T)
+	}{
+		{"detail", func(t *testing.T) {
+			p, err := repo.GetBySlug(ctx, "portfolio", Projection{})
+			if err != nil {
+				t.Fatalf("GetBySlug: %v", err)
+			}
+			p.Title = "changed"
+			*p.DemoURL = "https://evil.example.com"
+			p.Results[0] = "changed"
+			p.Tags[0].Name = "changed"
+			p.Features = append(p.Features[:0], models.ProjectFeature{Text: "changed"})
+			p.Screenshots = nil
+		}},
+		{"list", func(t *testing.T) {
+			items, _, err := repo.ListPublic(ctx, ProjectListParams{})
+			if err != nil {
+				t.Fatalf("ListPublic: %v", err)
+			}
+			items[0].Title = "changed"
+			items[0].Tags[0].Name = "changed"
+			*items[0].DemoURL = "https://evil.example.com"
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// isi cache, ubah hasilnya, lalu ambil lagi dari cache
+			tt.mutate(t)
+
+			p, err := repo.GetBySlug(ctx, "portfolio", Projection{})
+			if err != nil {
+				t.Fatalf("GetBySlug: %v", err)
+			}
+			items, _, err := repo.ListPublic(ctx, ProjectListParams{})
+			if err != nil {
+				t.Fatalf("ListPublic: %v", err)
+			}
+
+			for _, got := range []models.Project{*p, items[0]} {
+				if got.Title != "Portfolio" || *got.DemoURL != "https://demo.example.com" ||
+					got.Results[0] != "fast" || got.Tags[0].Name != "go" ||
+					got.Features[0].Text != "cursor pagination" || len(got.Screenshots) != 1 {
+					t.Fatalf("cached project was modified through a returned value: %+v", got)
+				}
+			}
+		})
+	}
+}
+
+func TestCachedLoadIgnoresCallerCancellation(t *testing.T) {
+	inner := &fakeProjectRepo{project: newFakeProject()}
+	repo := NewCachedProjectRepository(inner, cache.New(cache.Config{TTL: time.Minute, MaxEntries: 10}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := repo.GetBySlug(ctx, "portfolio", Projection{}); err != nil {
+		t.Fatalf("GetBySlug: %v", err)
+	}
+
+	if inner.loadErr != nil {
+		t.Fatalf("load context err = %v, want a live context", inner.loadErr)
+	}
+	if !inner.loadDeadline {
+		t.Fatal("load context has no deadline")
+	}
+}
@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"gorm.io/gorm"
+)
+
+type CertificationListParams struct {
+	Tag string // nama tag (case-insensitive)
+
+	// true = buang sertifikasi yang expiry_date-nya sudah sebelum Today
+	ExcludeExpired bool
+	Today          time.Time // models.CertificationToday, bukan CURRENT_DATE (timezone DB)
+}
+
+type CertificationRepository interface {
+	ListPublic(ctx context.Context, params CertificationListParams) ([]models.Certification, error)
+}
+
+type certificationRepository struct {
+	db *gorm.DB
+}
+
+func NewCertificationRepository(db *gorm.DB) CertificationRepository {
+	return &certificationRepository{db: db}
+}
+
+func (r *certificationRepository) ListPublic(ctx context.Context, params CertificationListParams) ([]models.Certification, error) {
+	var items []models.Certification
+
+	q := r.db.WithContext(ctx).Model(&models.Certification{})
+
+	if params.Tag != "" {
+		q = q.Where(
+			"EXISTS (SELECT 1 FROM certification_tags ct JOIN tags t ON t.id = ct.tag_id WHERE ct.certification_id = certifications.id AND LOWER(t.name) = ?)",
+			strings.ToLower(params.Tag),
+		)
+	}
+	if params.ExcludeExpired {
+		q = q.Where("(certifications.expiry_date IS NULL OR certifications.expiry_date >= ?)", params.Today.Format("2006-01-02"))
+	}
+
+	if err := q.
+		Preload("Tags").
+		Order("certifications.sort_order ASC").
+		Order("certifications.issue_date DESC").
+		Find(&items).Error; err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
@@ -5,19 +5,22 @@ import (
 	"strings"
 
 	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/FauzanParanditha/portfolio-backend/internal/pagination"
 	"gorm.io/gorm"
 )
 
 type ContactListParams struct {
-	Query  string
-	IsRead *bool
-	Page   int
-	Limit  int
+	Query     string
+	IsRead    *bool
+	Page      int
+	Limit     int
+	Cursor    *pagination.Cursor // kalau diisi, Page diabaikan
+	WithTotal bool
 }
 
 type ContactMessageRepository interface {
 	Create(ctx context.Context, m *models.ContactMessage) error
-	List(ctx context.Context, params ContactListParams) ([]models.ContactMessage, int64, error)
+	List(ctx context.Context, params ContactListParams) ([]models.ContactMessage, pagination.PageInfo, error)
 	GetByID(ctx context.Context, id string) (*models.ContactMessage, error)
 	MarkRead(ctx context.Context, id string, isRead bool) error
 	Delete(ctx context.Context, id string) error
@@ -35,9 +38,8 @@ func (r *contactMessageRepository) Create(ctx context.Context, m *models.Contact
 	return r.db.WithContext(ctx).Create(m).Error
 }
 
-func (r *contactMessageRepository) List(ctx context.Context, params ContactListParams) ([]models.ContactMessage, int64, error) {
+func (r *contactMessageRepository) List(ctx context.Context, params ContactListParams) ([]models.ContactMessage, pagination.PageInfo, error) {
 	var msgs []models.ContactMessage
-	var total int64
 
 	q := r.db.WithContext(ctx).Model(&models.ContactMessage{})
 
@@ -54,21 +56,30 @@ func (r *contactMessageRepository) List(ctx context.Context, params ContactListP
 		q = q.Where("is_read = ?", *params.IsRead)
 	}
 
-	if err := q.Count(&total).Error; err != nil {
-		return nil, 0, err
+	var total *int64
+	if params.WithTotal {
+		var n int64
+		if err := q.Count(&n).Error; err != nil {
+			return nil, pagination.PageInfo{}, err
+		}
+		total = &n
 	}
 
-	offset := (params.Page - 1) * params.Limit
+	pp := pagination.Params{
+		Page:   params.Page,
+		Limit:  params.Limit,
+		Cursor: params.Cursor,
+	}
 
-	if err := q.
-		Order("created_at DESC").
-		Limit(params.Limit).
-		Offset(offset).
+	if err := pagination.Apply(q, "contact_messages", false, pp).
 		Find(&msgs).Error; err != nil {
-		return nil, 0, err
+		return nil, pagination.PageInfo{}, err
 	}
 
-	return msgs, total, nil
+	msgs, info := pagination.Trim(msgs, pp)
+	info.Total = total
+
+	return msgs, info, nil
 }
 
 func (r *contactMessageRepository) GetByID(ctx context.Context, id string) (*models.ContactMessage, error) {
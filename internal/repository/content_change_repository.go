@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/changes"
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type contentChangeStore struct {
+	db *gorm.DB
+}
+
+// NewContentChangeStore: implementasi changes.Store di atas tabel content_changes
+func NewContentChangeStore(db *gorm.DB) changes.Store {
+	return &contentChangeStore{db: db}
+}
+
+func (s *contentChangeStore) Touch(ctx context.Context, topics []changes.Topic, at time.Time) error {
+	if len(topics) == 0 {
+		return nil
+	}
+
+	rows := make([]models.ContentChange, 0, len(topics))
+	for _, t := range topics {
+		rows = append(rows, models.ContentChange{Topic: string(t), ChangedAt: at})
+	}
+
+	// GREATEST: jam antar instance bisa sedikit beda, waktu tidak boleh mundur
+	return s.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "topic"}},
+			DoUpdates: clause.Set{{
+				Column: clause.Column{Name: "changed_at"},
+				Value:  gorm.Expr("GREATEST(content_changes.changed_at, EXCLUDED.changed_at)"),
+			}},
+		}).
+		Create(&rows).Error
+}
+
+func (s *contentChangeStore) All(ctx context.Context) (map[changes.Topic]time.Time, error) {
+	var rows []models.ContentChange
+	if err := s.db.WithContext(ctx).Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	out := make(map[changes.Topic]time.Time, len(rows))
+	for _, r := range rows {
+		out[changes.Topic(r.Topic)] = r.ChangedAt
+	}
+	return out, nil
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"gorm.io/gorm"
+)
+
+// HealthQuery: SQL satu lint rule, harus menghasilkan kolom id & label
+type HealthQuery struct {
+	Rule string
+	SQL  string
+}
+
+type ContentHealthRepository interface {
+	// Find menjalankan semua rule dalam satu query (UNION ALL)
+	Find(ctx context.Context, queries []HealthQuery) ([]models.ContentIssueRow, error)
+	Count(ctx context.Context, queries []HealthQuery) (int64, error)
+}
+
+type contentHealthRepository struct {
+	db *gorm.DB
+}
+
+func NewContentHealthRepository(db *gorm.DB) ContentHealthRepository {
+	return &contentHealthRepository{db: db}
+}
+
+// unionSQL: rule id dikirim sebagai parameter, SQL rule berasal dari kode (bukan input user)
+func unionSQL(queries []HealthQuery) (string, []any) {
+	parts := make([]string, 0, len(queries))
+	args := make([]any, 0, len(queries)*2)
+
+	for i, q := range queries {
+		parts = append(parts, fmt.Sprintf(
+			"SELECT CAST(? AS text) AS rule, CAST(? AS int) AS rule_order, r.id AS entity_id, r.label AS label FROM (%s) r",
+			q.SQL,
+		))
+		args = append(args, q.Rule, i)
+	}
+
+	return strings.Join(parts, "\nUNION ALL\n"), args
+}
+
+func (r *contentHealthRepository) Find(ctx context.Context, queries []HealthQuery) ([]models.ContentIssueRow, error) {
+	if len(queries) == 0 {
+		return []models.ContentIssueRow{}, nil
+	}
+
+	union, args := unionSQL(queries)
+
+	var rows []models.ContentIssueRow
+	err := r.db.WithContext(ctx).
+		Raw("SELECT rule, entity_id, label FROM ("+union+") issues ORDER BY rule_order ASC, label ASC", args...).
+		Scan(&rows).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+func (r *contentHealthRepository) Count(ctx context.Context, queries []HealthQuery) (int64, error) {
+	if len(queries) == 0 {
+		return 0, nil
+	}
+
+	union, args := unionSQL(queries)
+
+	var n int64
+	err := r.db.WithContext(ctx).
+		Raw("SELECT COUNT(*) FROM ("+union+") issues", args...).
+		Scan(&n).Error
+
+	return n, err
+}
@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"gorm.io/gorm"
+)
+
+type DashboardRepository interface {
+	// Counts: semua angka overview dalam satu round trip
+	Counts(ctx context.Context, since time.Time) (models.DashboardCounts, error)
+	// DailyCreated: bucket harian mulai from (tengah malam di timezone from.Location()),
+	// sebanyak days hari. Hari kosong = 0.
+	DailyCreated(ctx context.Context, from time.Time, days int) ([]models.DashboardDailyStat, error)
+}
+
+type dashboardRepository struct {
+	db *gorm.DB
+}
+
+func NewDashboardRepository(db *gorm.DB) DashboardRepository {
+	return &dashboardRepository{db: db}
+}
+
+const dashboardCountsSQL = `
+SELECT p.*, e.*, m.*, l.*
+FROM (
+	SELECT
+		COUNT(*) AS projects_total,
+		COUNT(*) FILTER (WHERE is_featured) AS projects_featured,
+		COUNT(*) FILTER (WHERE created_at >= @since) AS projects_recent
+	FROM projects
+) p
+CROSS JOIN (
+	SELECT
+		COUNT(*) AS experiences_total,
+		COUNT(*) FILTER (WHERE is_current) AS experiences_current,
+		COUNT(*) FILTER (WHERE created_at >= @since) AS experiences_recent
+	FROM experiences
+) e
+CROSS JOIN (
+	SELECT
+		COUNT(*) AS contact_total,
+		COUNT(*) FILTER (WHERE NOT is_read) AS contact_unread,
+		COUNT(*) FILTER (WHERE created_at >= @since) AS contact_recent
+	FROM contact_messages
+) m
+CROSS JOIN (
+	SELECT
+		COUNT(*) AS links_total,
+		COUNT(*) FILTER (WHERE is_broken) AS links_broken
+	FROM link_checks
+) l`
+
+func (r *dashboardRepository) Counts(ctx context.Context, since time.Time) (models.DashboardCounts, error) {
+	var counts models.DashboardCounts
+
+	err := r.db.WithContext(ctx).
+		Raw(dashboardCountsSQL, sql.Named("since", since)).
+		Scan(&counts).Error
+
+	return counts, err
+}
+
+const dashboardDailySQL = `
+SELECT
+	to_char(d.day, 'YYYY-MM-DD') AS day,
+	COALESCE(p.n, 0) AS projects,
+	COALESCE(e.n, 0) AS experiences,
+	COALESCE(m.n, 0) AS contact_messages
+FROM generate_series(CAST(@from AS date), CAST(@to AS date), interval '1 day') AS d(day)
+LEFT JOIN (
+	SELECT (created_at AT TIME ZONE @tz)::date AS day, COUNT(*) AS n
+	FROM projects
+	WHERE created_at >= @start AND created_at < @end
+	GROUP BY 1
+) p ON p.day = d.day
+LEFT JOIN (
+	SELECT (created_at AT TIME ZONE @tz)::date AS day, COUNT(*) AS n
+	FROM experiences
+	WHERE created_at >= @start AND created_at < @end
+	GROUP BY 1
+) e ON e.day = d.day
+LEFT JOIN (
+	SELECT (created_at AT TIME ZONE @tz)::date AS day, COUNT(*) AS n
+	FROM contact_messages
+	WHERE created_at >= @start AND created_at < @end
+	GROUP BY 1
+) m ON m.day = d.day
+ORDER BY d.day ASC`
+
+func (r *dashboardRepository) DailyCreated(ctx context.Context, from time.Time, days int) ([]models.DashboardDailyStat, error) {
+	var stats []models.DashboardDailyStat
+
+	end := from.AddDate(0, 0, days)
+
+	err := r.db.WithContext(ctx).
+		Raw(dashboardDailySQL,
+			sql.Named("from", from.Format("2006-01-02")),
+			sql.Named("to", end.AddDate(0, 0, -1).Format("2006-01-02")),
+			sql.Named("start", from),
+			sql.Named("end", end),
+			sql.Named("tz", from.Location().String()),
+		).
+		Scan(&stats).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"strings"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"gorm.io/gorm"
+)
+
+type EducationListParams struct {
+	Tag string // nama tag (case-insensitive)
+}
+
+type EducationRepository interface {
+	ListPublic(ctx context.Context, params EducationListParams) ([]models.Education, error)
+}
+
+type educationRepository struct {
+	db *gorm.DB
+}
+
+func NewEducationRepository(db *gorm.DB) EducationRepository {
+	return &educationRepository{db: db}
+}
+
+func (r *educationRepository) ListPublic(ctx context.Context, params EducationListParams) ([]models.Education, error) {
+	var items []models.Education
+
+	q := r.db.WithContext(ctx).Model(&models.Education{})
+
+	if params.Tag != "" {
+		q = q.Where(
+			"EXISTS (SELECT 1 FROM education_tags et JOIN tags t ON t.id = et.tag_id WHERE et.education_id = educations.id AND LOWER(t.name) = ?)",
+			strings.ToLower(params.Tag),
+		)
+	}
+
+	if err := q.
+		Preload("Tags").
+		Order("educations.sort_order ASC").
+		Order("educations.start_date DESC").
+		Find(&items).Error; err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
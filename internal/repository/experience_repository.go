@@ -2,13 +2,35 @@ package repository
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/FauzanParanditha/portfolio-backend/internal/pagination"
 	"gorm.io/gorm"
 )
 
+type ExperienceListParams struct {
+	Tag     string // nama tag (case-insensitive)
+	TagType string // punya minimal satu tag dengan type ini (mis. "tech")
+
+	// experience yang masa kerjanya beririsan dengan [From, To]; nil = tanpa batas
+	From *time.Time
+	To   *time.Time
+
+	CurrentOnly bool
+
+	// Limit 0 = tanpa pagination (semua experience, seperti sebelumnya)
+	Page      int
+	Limit     int
+	Cursor    *pagination.Cursor // kalau diisi, Page diabaikan
+	WithTotal bool
+
+	Projection Projection
+}
+
 type ExperienceRepository interface {
-	ListPublic(ctx context.Context) ([]models.Experience, error)
+	ListPublic(ctx context.Context, params ExperienceListParams) ([]models.Experience, pagination.PageInfo, error)
 }
 
 type experienceRepository struct {
@@ -19,21 +41,70 @@ func NewExperienceRepository(db *gorm.DB) ExperienceRepository {
 	return &experienceRepository{db: db}
 }
 
-func (r *experienceRepository) ListPublic(ctx context.Context) ([]models.Experience, error) {
+func (r *experienceRepository) ListPublic(ctx context.Context, params ExperienceListParams) ([]models.Experience, pagination.PageInfo, error) {
 	var exps []models.Experience
 
-	err := r.db.WithContext(ctx).
-		Preload("Highlights", func(db *gorm.DB) *gorm.DB {
-			return db.Order("experience_highlights.sort_order ASC")
-		}).
-		Preload("Tags").
-		Order("experiences.sort_order ASC").
-		Order("experiences.start_date DESC").
-		Find(&exps).Error
+	q := r.db.Model(&models.Experience{})
 
-	if err != nil {
-		return nil, err
+	if params.Tag != "" {
+		q = q.Where(
+			"EXISTS (SELECT 1 FROM experience_tags et JOIN tags t ON t.id = et.tag_id WHERE et.experience_id = experiences.id AND LOWER(t.name) = ?)",
+			strings.ToLower(params.Tag),
+		)
 	}
+	if params.TagType != "" {
+		q = q.Where(
+			"EXISTS (SELECT 1 FROM experience_tags et JOIN tags t ON t.id = et.tag_id WHERE et.experience_id = experiences.id AND LOWER(t.type) = ?)",
+			strings.ToLower(params.TagType),
+		)
+	}
+	if params.From != nil {
+		q = q.Where("(experiences.is_current = true OR experiences.end_date IS NULL OR experiences.end_date >= ?)", *params.From)
+	}
+	if params.To != nil {
+		q = q.Where("experiences.start_date <= ?", *params.To)
+	}
+	if params.CurrentOnly {
+		q = q.Where("experiences.is_current = true")
+	}
+
+	// tanpa pagination
+	if params.Limit <= 0 {
+		if err := ExperienceQuery(q.WithContext(ctx), params.Projection).
+			Order("experiences.sort_order ASC").
+			Order("experiences.start_date DESC").
+			Find(&exps).Error; err != nil {
+			return nil, pagination.PageInfo{}, err
+		}
+		return exps, pagination.PageInfo{}, nil
+	}
+
+	var total *int64
+	if params.WithTotal {
+		var n int64
+		if err := q.WithContext(ctx).Count(&n).Error; err != nil {
+			return nil, pagination.PageInfo{}, err
+		}
+		total = &n
+	}
+
+	if params.Page < 1 {
+		params.Page = 1
+	}
+
+	pp := pagination.Params{
+		Page:   params.Page,
+		Limit:  params.Limit,
+		Cursor: params.Cursor,
+	}
+
+	if err := pagination.ApplyWithSortOrder(ExperienceQuery(q.WithContext(ctx), params.Projection), "experiences", "start_date", pp).
+		Find(&exps).Error; err != nil {
+		return nil, pagination.PageInfo{}, err
+	}
+
+	exps, info := pagination.Trim(exps, pp)
+	info.Total = total
 
-	return exps, nil
+	return exps, info, nil
 }
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type LinkCheckListParams struct {
+	BrokenOnly bool
+	Kind       string
+}
+
+type LinkCheckRepository interface {
+	// ListTargets: kumpulkan semua URL project (cover, demo, repo, screenshot) yang perlu dicek
+	ListTargets(ctx context.Context) ([]models.LinkCheck, error)
+	Upsert(ctx context.Context, lc *models.LinkCheck) error
+	// DeleteMissing: hapus hasil untuk URL yang tidak ada lagi di targets
+	DeleteMissing(ctx context.Context, targets []models.LinkCheck) error
+	List(ctx context.Context, params LinkCheckListParams) ([]models.LinkCheck, error)
+}
+
+type linkCheckRepository struct {
+	db *gorm.DB
+}
+
+func NewLinkCheckRepository(db *gorm.DB) LinkCheckRepository {
+	return &linkCheckRepository{db: db}
+}
+
+func (r *linkCheckRepository) ListTargets(ctx context.Context) ([]models.LinkCheck, error) {
+	var projects []models.Project
+
+	if err := r.db.WithContext(ctx).
+		Select("id", "cover_image_url", "demo_url", "repo_url").
+		Preload("Screenshots", func(db *gorm.DB) *gorm.DB {
+			return db.Select("id", "project_id", "image_url")
+		}).
+		Find(&projects).Error; err != nil {
+		return nil, err
+	}
+
+	targets := make([]models.LinkCheck, 0, len(projects)*3)
+	add := func(projectID uuid.UUID, kind, url string) {
+		if url == "" {
+			return
+		}
+		targets = append(targets, models.LinkCheck{
+			ProjectID: projectID,
+			Kind:      kind,
+			URL:       url,
+		})
+	}
+
+	for _, p := range projects {
+		add(p.ID, models.LinkKindCover, p.CoverImageURL)
+		if p.DemoURL != nil {
+			add(p.ID, models.LinkKindDemo, *p.DemoURL)
+		}
+		if p.RepoURL != nil {
+			add(p.ID, models.LinkKindRepo, *p.RepoURL)
+		}
+		for _, s := range p.Screenshots {
+			add(p.ID, models.LinkKindScreenshot, s.ImageURL)
+		}
+	}
+
+	return targets, nil
+}
+
+func (r *linkCheckRepository) Upsert(ctx context.Context, lc *models.LinkCheck) error {
+	return r.db.WithContext(ctx).
+		Omit("Project").
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "project_id"}, {Name: "kind"}, {Name: "url"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"status_code", "is_broken", "error", "latency_ms", "checked_at", "updated_at",
+			}),
+		}).
+		Create(lc).Error
+}
+
+// DeleteMissing: hapus hasil lama untuk URL yang sudah tidak dipakai project
+func (r *linkCheckRepository) DeleteMissing(ctx context.Context, targets []models.LinkCheck) error {
+	projectIDs := make([]string, 0, len(targets))
+	kinds := make([]string, 0, len(targets))
+	urls := make([]string, 0, len(targets))
+	for _, t := range targets {
+		projectIDs = append(projectIDs, t.ProjectID.String())
+		kinds = append(kinds, t.Kind)
+		urls = append(urls, t.URL)
+	}
+
+	return r.db.WithContext(ctx).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM unnest(?::uuid[], ?::text[], ?::text[]) AS t(project_id, kind, url)
+			WHERE t.project_id = link_checks.project_id AND t.kind = link_checks.kind AND t.url = link_checks.url
+		)`, pq.Array(projectIDs), pq.Array(kinds), pq.Array(urls)).
+		Delete(&models.LinkCheck{}).Error
+}
+
+func (r *linkCheckRepository) List(ctx context.Context, params LinkCheckListParams) ([]models.LinkCheck, error) {
+	var checks []models.LinkCheck
+
+	q := r.db.WithContext(ctx).
+		Preload("Project", func(db *gorm.DB) *gorm.DB {
+			return db.Select("id", "title", "slug")
+		}).
+		Model(&models.LinkCheck{})
+
+	if params.BrokenOnly {
+		q = q.Where("link_checks.is_broken = ?", true)
+	}
+	if params.Kind != "" {
+		q = q.Where("link_checks.kind = ?", params.Kind)
+	}
+
+	if err := q.
+		Order("link_checks.is_broken DESC").
+		Order("link_checks.checked_at DESC").
+		Find(&checks).Error; err != nil {
+		return nil, err
+	}
+
+	return checks, nil
+}
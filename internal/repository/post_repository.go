@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"strings"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/FauzanParanditha/portfolio-backend/internal/pagination"
+	"gorm.io/gorm"
+)
+
+type PostListParams struct {
+	Query     string
+	Tag       string // nama tag (case-insensitive), kosong = semua
+	Page      int
+	Limit     int
+	Cursor    *pagination.Cursor // kalau diisi, Page diabaikan
+	WithTotal bool
+}
+
+type PostRepository interface {
+	// ListPublic: hanya post published dengan published_at <= now(), terbaru dulu
+	ListPublic(ctx context.Context, params PostListParams) ([]models.Post, pagination.PageInfo, error)
+	GetBySlug(ctx context.Context, slug string) (*models.Post, error)
+}
+
+type postRepository struct {
+	db *gorm.DB
+}
+
+func NewPostRepository(db *gorm.DB) PostRepository {
+	return &postRepository{db: db}
+}
+
+// publishedScope: post yang sudah tayang (bukan draft, bukan terjadwal)
+func publishedScope(q *gorm.DB) *gorm.DB {
+	return q.
+		Where("posts.status = ?", models.PostStatusPublished).
+		Where("posts.published_at IS NOT NULL AND posts.published_at <= now()")
+}
+
+func (r *postRepository) ListPublic(ctx context.Context, params PostListParams) ([]models.Post, pagination.PageInfo, error) {
+	var posts []models.Post
+
+	q := publishedScope(r.db.Model(&models.Post{}))
+
+	if params.Query != "" {
+		like := "%" + strings.ToLower(params.Query) + "%"
+		q = q.Where(
+			r.db.
+				Where("LOWER(posts.title) LIKE ?", like).
+				Or("LOWER(posts.excerpt) LIKE ?", like).
+				Or("LOWER(posts.body) LIKE ?", like),
+		)
+	}
+
+	if params.Tag != "" {
+		q = q.Where(
+			"EXISTS (SELECT 1 FROM post_tags pt JOIN tags t ON t.id = pt.tag_id WHERE pt.post_id = posts.id AND LOWER(t.name) = ?)",
+			strings.ToLower(params.Tag),
+		)
+	}
+
+	var total *int64
+	if params.WithTotal {
+		var n int64
+		if err := q.WithContext(ctx).Count(&n).Error; err != nil {
+			return nil, pagination.PageInfo{}, err
+		}
+		total = &n
+	}
+
+	if params.Page < 1 {
+		params.Page = 1
+	}
+	if params.Limit <= 0 {
+		params.Limit = 10
+	}
+
+	pp := pagination.Params{
+		Page:   params.Page,
+		Limit:  params.Limit,
+		Cursor: params.Cursor,
+	}
+
+	if err := pagination.ApplyByTime(q.WithContext(ctx).Preload("Tags"), "posts", "published_at", pp).
+		Find(&posts).Error; err != nil {
+		return nil, pagination.PageInfo{}, err
+	}
+
+	posts, info := pagination.Trim(posts, pp)
+	info.Total = total
+
+	return posts, info, nil
+}
+
+func (r *postRepository) GetBySlug(ctx context.Context, slug string) (*models.Post, error) {
+	var p models.Post
+
+	if err := publishedScope(r.db.WithContext(ctx)).
+		Preload("Tags").
+		Where("posts.slug = ?", slug).
+		First(&p).Error; err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
@@ -5,19 +5,30 @@ import (
 	"strings"
 
 	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/FauzanParanditha/portfolio-backend/internal/pagination"
+	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
 type ProjectListParams struct {
 	FeaturedOnly bool
 	Query        string
+	ExperienceID *uuid.UUID // hanya project yang terhubung ke experience ini
 	Page         int
 	Limit        int
+	Cursor       *pagination.Cursor // kalau diisi, Page diabaikan
+	WithTotal    bool
+	Projection   Projection
 }
 
 type ProjectRepository interface {
-	ListPublic(ctx context.Context, params ProjectListParams) ([]models.Project, int64, error)
-	GetBySlug(ctx context.Context, slug string) (*models.Project, error)
+	ListPublic(ctx context.Context, params ProjectListParams) ([]models.Project, pagination.PageInfo, error)
+	GetBySlug(ctx context.Context, slug string, proj Projection) (*models.Project, error)
+	// GetNeighbors: project sebelum/sesudah p dengan urutan yang sama seperti ListPublic
+	GetNeighbors(ctx context.Context, p *models.Project, sameCategory bool) (prev, next *models.Project, err error)
+	// ListRecent: project terbaru (created_at DESC) + tags, untuk feed. tag kosong = semua.
+	// Project tidak punya status publish, jadi semua project dianggap publik (sama seperti ListPublic)
+	ListRecent(ctx context.Context, tag string, limit int) ([]models.Project, error)
 }
 
 type projectRepository struct {
@@ -28,32 +39,28 @@ func NewProjectRepository(db *gorm.DB) ProjectRepository {
 	return &projectRepository{db: db}
 }
 
-// baseQuery: preload semua relasi yang dibutuhkan untuk public response
-func (r *projectRepository) baseQuery() *gorm.DB {
-	return r.db.
-		Preload("Features", func(db *gorm.DB) *gorm.DB {
-			return db.Order("project_features.sort_order ASC")
-		}).
-		Preload("Tags").
-		Preload("Screenshots", func(db *gorm.DB) *gorm.DB {
-			return db.Order("project_screenshots.sort_order ASC")
-		}).
-		Order("projects.sort_order ASC").
-		Order("projects.created_at DESC")
+// baseQuery: kolom & relasi untuk public response sesuai projection.
+// Urutan list (sort_order ASC, created_at DESC, id DESC) dipasang oleh pagination.Apply.
+func (r *projectRepository) baseQuery(proj Projection) *gorm.DB {
+	return ProjectQuery(r.db, proj)
 }
 
-func (r *projectRepository) ListPublic(ctx context.Context, params ProjectListParams) ([]models.Project, int64, error) {
-	var (
-		projects []models.Project
-		total    int64
-	)
+func (r *projectRepository) ListPublic(ctx context.Context, params ProjectListParams) ([]models.Project, pagination.PageInfo, error) {
+	var projects []models.Project
 
-	q := r.baseQuery().Model(&models.Project{})
+	q := r.db.Model(&models.Project{})
 
 	if params.FeaturedOnly {
 		q = q.Where("projects.is_featured = ?", true)
 	}
 
+	if params.ExperienceID != nil {
+		q = q.Where(
+			"EXISTS (SELECT 1 FROM experience_projects ep WHERE ep.project_id = projects.id AND ep.experience_id = ?)",
+			*params.ExperienceID,
+		)
+	}
+
 	if params.Query != "" {
 		like := "%" + strings.ToLower(params.Query) + "%"
 		q = q.Where(
@@ -65,9 +72,14 @@ func (r *projectRepository) ListPublic(ctx context.Context, params ProjectListPa
 		)
 	}
 
-	// hitung total (untuk pagination)
-	if err := q.WithContext(ctx).Count(&total).Error; err != nil {
-		return nil, 0, err
+	// total opsional (?withTotal=true), COUNT(*) mahal untuk list besar
+	var total *int64
+	if params.WithTotal {
+		var n int64
+		if err := q.WithContext(ctx).Count(&n).Error; err != nil {
+			return nil, pagination.PageInfo{}, err
+		}
+		total = &n
 	}
 
 	// pagination
@@ -78,22 +90,30 @@ func (r *projectRepository) ListPublic(ctx context.Context, params ProjectListPa
 		params.Limit = 12
 	}
 
-	offset := (params.Page - 1) * params.Limit
+	pp := pagination.Params{
+		Page:   params.Page,
+		Limit:  params.Limit,
+		Cursor: params.Cursor,
+	}
+
+	// Select/Preload dipasang setelah COUNT supaya count tetap COUNT(*)
+	q = ProjectQuery(q, params.Projection)
 
-	if err := q.WithContext(ctx).
-		Limit(params.Limit).
-		Offset(offset).
+	if err := pagination.Apply(q.WithContext(ctx), "projects", true, pp).
 		Find(&projects).Error; err != nil {
-		return nil, 0, err
+		return nil, pagination.PageInfo{}, err
 	}
 
-	return projects, total, nil
+	projects, info := pagination.Trim(projects, pp)
+	info.Total = total
+
+	return projects, info, nil
 }
 
-func (r *projectRepository) GetBySlug(ctx context.Context, slug string) (*models.Project, error) {
+func (r *projectRepository) GetBySlug(ctx context.Context, slug string, proj Projection) (*models.Project, error) {
 	var p models.Project
 
-	if err := r.baseQuery().
+	if err := r.baseQuery(proj).
 		WithContext(ctx).
 		Where("projects.slug = ?", slug).
 		First(&p).Error; err != nil {
@@ -106,3 +126,77 @@ func (r *projectRepository) GetBySlug(ctx context.Context, slug string) (*models
 
 	return &p, nil
 }
+
+func (r *projectRepository) GetNeighbors(ctx context.Context, p *models.Project, sameCategory bool) (*models.Project, *models.Project, error) {
+	find := func(where string, order string) (*models.Project, error) {
+		q := r.db.WithContext(ctx).
+			Model(&models.Project{}).
+			Select("projects.id", "projects.slug", "projects.title", "projects.cover_image_url").
+			Where(where,
+				p.SortOrder,
+				p.SortOrder, p.CreatedAt,
+				p.SortOrder, p.CreatedAt, p.ID,
+			)
+
+		if sameCategory {
+			q = q.Where("projects.category = ?", p.Category)
+		}
+
+		var n models.Project
+		if err := q.Order(order).Limit(1).Take(&n).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return &n, nil
+	}
+
+	// urutan: sort_order ASC, created_at DESC (id DESC sebagai tie-breaker)
+	prev, err := find(
+		"(projects.sort_order < ?) OR "+
+			"(projects.sort_order = ? AND projects.created_at > ?) OR "+
+			"(projects.sort_order = ? AND projects.created_at = ? AND projects.id > ?)",
+		"projects.sort_order DESC, projects.created_at ASC, projects.id ASC",
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	next, err := find(
+		"(projects.sort_order > ?) OR "+
+			"(projects.sort_order = ? AND projects.created_at < ?) OR "+
+			"(projects.sort_order = ? AND projects.created_at = ? AND projects.id < ?)",
+		"projects.sort_order ASC, projects.created_at DESC, projects.id DESC",
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return prev, next, nil
+}
+
+func (r *projectRepository) ListRecent(ctx context.Context, tag string, limit int) ([]models.Project, error) {
+	var projects []models.Project
+
+	q := r.db.WithContext(ctx).
+		Model(&models.Project{}).
+		Preload("Tags")
+
+	if tag != "" {
+		q = q.Where(
+			"EXISTS (SELECT 1 FROM project_tags pt JOIN tags t ON t.id = pt.tag_id WHERE pt.project_id = projects.id AND LOWER(t.name) = ?)",
+			strings.ToLower(tag),
+		)
+	}
+
+	if err := q.
+		Order("projects.created_at DESC").
+		Order("projects.id DESC").
+		Limit(limit).
+		Find(&projects).Error; err != nil {
+		return nil, err
+	}
+
+	return projects, nil
+}
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"gorm.io/gorm"
+)
+
+type ProjectSchemaRepository interface {
+	List(ctx context.Context) ([]models.ProjectSchema, error)
+	GetByID(ctx context.Context, id string) (*models.ProjectSchema, error)
+	GetByCategory(ctx context.Context, category string) (*models.ProjectSchema, error)
+	Create(ctx context.Context, s *models.ProjectSchema) error
+	Update(ctx context.Context, s *models.ProjectSchema) error
+	Delete(ctx context.Context, id string) error
+}
+
+type projectSchemaRepository struct {
+	db *gorm.DB
+}
+
+func NewProjectSchemaRepository(db *gorm.DB) ProjectSchemaRepository {
+	return &projectSchemaRepository{db: db}
+}
+
+func (r *projectSchemaRepository) List(ctx context.Context) ([]models.ProjectSchema, error) {
+	var schemas []models.ProjectSchema
+	if err := r.db.WithContext(ctx).Order("category ASC").Find(&schemas).Error; err != nil {
+		return nil, err
+	}
+	return schemas, nil
+}
+
+func (r *projectSchemaRepository) GetByID(ctx context.Context, id string) (*models.ProjectSchema, error) {
+	var s models.ProjectSchema
+	if err := r.db.WithContext(ctx).First(&s, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (r *projectSchemaRepository) GetByCategory(ctx context.Context, category string) (*models.ProjectSchema, error) {
+	var s models.ProjectSchema
+	if err := r.db.WithContext(ctx).First(&s, "category = ?", category).Error; err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (r *projectSchemaRepository) Create(ctx context.Context, s *models.ProjectSchema) error {
+	return r.db.WithContext(ctx).Create(s).Error
+}
+
+func (r *projectSchemaRepository) Update(ctx context.Context, s *models.ProjectSchema) error {
+	return r.db.WithContext(ctx).Save(s).Error
+}
+
+func (r *projectSchemaRepository) Delete(ctx context.Context, id string) error {
+	return r.db.WithContext(ctx).Delete(&models.ProjectSchema{}, "id = ?", id).Error
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+)
+
+// Projection: kolom & relasi yang perlu di-load. Zero value = semua kolom + semua relasi.
+type Projection struct {
+	Columns   []string        // kolom tabel utama (tanpa prefix), kosong = semua
+	Relations map[string]bool // nama relasi gorm (mis. "Tags"), nil = semua
+}
+
+// Preloads: apakah relasi name perlu di-preload
+func (p Projection) Preloads(name string) bool {
+	return p.Relations == nil || p.Relations[name]
+}
+
+func (p Projection) selectColumns(q *gorm.DB, table string) *gorm.DB {
+	if len(p.Columns) == 0 {
+		return q
+	}
+
+	cols := make([]string, 0, len(p.Columns))
+	for _, c := range p.Columns {
+		cols = append(cols, table+"."+c)
+	}
+
+	return q.Select(cols)
+}
+
+// ProjectQuery: pasang Select + Preload sesuai projection (dipakai public & admin)
+func ProjectQuery(q *gorm.DB, proj Projection) *gorm.DB {
+	q = proj.selectColumns(q, "projects")
+
+	if proj.Preloads("Features") {
+		q = q.Preload("Features", func(db *gorm.DB) *gorm.DB {
+			return db.Order("project_features.sort_order ASC")
+		})
+	}
+	if proj.Preloads("Tags") {
+		q = q.Preload("Tags")
+	}
+	if proj.Preloads("Screenshots") {
+		q = q.Preload("Screenshots", func(db *gorm.DB) *gorm.DB {
+			return db.Order("project_screenshots.sort_order ASC")
+		})
+	}
+	if proj.Preloads("Experiences") {
+		q = q.Preload("Experiences", func(db *gorm.DB) *gorm.DB {
+			return db.Order("experiences.start_date DESC")
+		})
+	}
+	if proj.Preloads("Client") {
+		q = q.Preload("Client")
+	}
+
+	return q
+}
+
+// ExperienceQuery: pasang Select + Preload sesuai projection (dipakai public & admin)
+func ExperienceQuery(q *gorm.DB, proj Projection) *gorm.DB {
+	q = proj.selectColumns(q, "experiences")
+
+	if proj.Preloads("Highlights") {
+		q = q.Preload("Highlights", func(db *gorm.DB) *gorm.DB {
+			return db.Order("experience_highlights.sort_order ASC")
+		})
+	}
+	if proj.Preloads("Tags") {
+		q = q.Preload("Tags")
+	}
+	if proj.Preloads("Projects") {
+		q = q.Preload("Projects", func(db *gorm.DB) *gorm.DB {
+			return db.Order("projects.sort_order ASC").Order("projects.created_at DESC")
+		})
+	}
+	if proj.Preloads("Organization") {
+		q = q.Preload("Organization")
+	}
+
+	return q
+}
@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"gorm.io/gorm"
+)
+
+type SitemapRepository interface {
+	Projects(ctx context.Context) ([]models.SitemapEntry, error)
+	Posts(ctx context.Context) ([]models.SitemapEntry, error)
+}
+
+type sitemapRepository struct {
+	db *gorm.DB
+}
+
+func NewSitemapRepository(db *gorm.DB) SitemapRepository {
+	return &sitemapRepository{db: db}
+}
+
+func (r *sitemapRepository) Projects(ctx context.Context) ([]models.SitemapEntry, error) {
+	var entries []models.SitemapEntry
+
+	err := r.db.WithContext(ctx).
+		Model(&models.Project{}).
+		Select("slug, updated_at").
+		Order("sort_order ASC").
+		Order("created_at DESC").
+		Scan(&entries).Error
+
+	return entries, err
+}
+
+// Posts: hanya yang sudah terbit; post terjadwal dihitung berubah saat published_at lewat
+func (r *sitemapRepository) Posts(ctx context.Context) ([]models.SitemapEntry, error) {
+	var entries []models.SitemapEntry
+
+	err := r.db.WithContext(ctx).
+		Model(&models.Post{}).
+		Scopes(publishedScope).
+		Select("slug, GREATEST(updated_at, published_at) AS updated_at").
+		Order("published_at DESC").
+		Scan(&entries).Error
+
+	return entries, err
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type SkillRepository interface {
+	Tags(ctx context.Context) ([]models.Tag, error)
+	Periods(ctx context.Context) ([]models.SkillPeriod, error)
+	ProjectStats(ctx context.Context) ([]models.SkillProjectStat, error)
+
+	ListOverrides(ctx context.Context) ([]models.SkillOverride, error)
+	UpsertOverride(ctx context.Context, o *models.SkillOverride) error
+	DeleteOverride(ctx context.Context, tagID string) error
+}
+
+type skillRepository struct {
+	db *gorm.DB
+}
+
+func NewSkillRepository(db *gorm.DB) SkillRepository {
+	return &skillRepository{db: db}
+}
+
+func (r *skillRepository) Tags(ctx context.Context) ([]models.Tag, error) {
+	var tags []models.Tag
+	err := r.db.WithContext(ctx).Order("type ASC").Order("name ASC").Find(&tags).Error
+	return tags, err
+}
+
+func (r *skillRepository) Periods(ctx context.Context) ([]models.SkillPeriod, error) {
+	var rows []models.SkillPeriod
+
+	err := r.db.WithContext(ctx).
+		Table("experience_tags et").
+		Select("et.tag_id, e.start_date, e.end_date, e.is_current").
+		Joins("JOIN experiences e ON e.id = et.experience_id").
+		Scan(&rows).Error
+
+	return rows, err
+}
+
+func (r *skillRepository) ProjectStats(ctx context.Context) ([]models.SkillProjectStat, error) {
+	var rows []models.SkillProjectStat
+
+	err := r.db.WithContext(ctx).
+		Table("project_tags pt").
+		Select("pt.tag_id, COUNT(*) AS project_count, MAX(p.created_at) AS last_project_at").
+		Joins("JOIN projects p ON p.id = pt.project_id").
+		Group("pt.tag_id").
+		Scan(&rows).Error
+
+	return rows, err
+}
+
+func (r *skillRepository) ListOverrides(ctx context.Context) ([]models.SkillOverride, error) {
+	var rows []models.SkillOverride
+	err := r.db.WithContext(ctx).Find(&rows).Error
+	return rows, err
+}
+
+func (r *skillRepository) UpsertOverride(ctx context.Context, o *models.SkillOverride) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "tag_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"proficiency", "sort_order", "hidden", "updated_at"}),
+		}).
+		Create(o).Error
+}
+
+func (r *skillRepository) DeleteOverride(ctx context.Context, tagID string) error {
+	res := r.db.WithContext(ctx).Where("tag_id = ?", tagID).Delete(&models.SkillOverride{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
@@ -5,17 +5,20 @@ import (
 	"strings"
 
 	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/FauzanParanditha/portfolio-backend/internal/pagination"
 	"gorm.io/gorm"
 )
 
 type TagListParams struct {
-	Query string
-	Page  int
-	Limit int
+	Query     string
+	Page      int
+	Limit     int
+	Cursor    *pagination.Cursor // kalau diisi, Page diabaikan
+	WithTotal bool
 }
 
 type TagRepository interface {
-	List(ctx context.Context, params TagListParams) ([]models.Tag, int64, error)
+	List(ctx context.Context, params TagListParams) ([]models.Tag, pagination.PageInfo, error)
 	GetByID(ctx context.Context, id string) (*models.Tag, error)
 	Create(ctx context.Context, tag *models.Tag) error
 	Update(ctx context.Context, tag *models.Tag) error
@@ -30,34 +33,42 @@ func NewTagRepository(db *gorm.DB) TagRepository {
 	return &tagRepository{db: db}
 }
 
-func (r *tagRepository) List(ctx context.Context, params TagListParams) ([]models.Tag, int64, error) {
+func (r *tagRepository) List(ctx context.Context, params TagListParams) ([]models.Tag, pagination.PageInfo, error) {
 	var tags []models.Tag
-	var total int64
 
 	q := r.db.WithContext(ctx).Model(&models.Tag{})
 
 	if params.Query != "" {
 		like := "%" + strings.ToLower(params.Query) + "%"
-		q = q.Where("LOWER(name) LIKE ?", like)
+		q = q.Where("LOWER(tags.name) LIKE ?", like)
 	}
 
-	if err := q.Count(&total).Error; err != nil {
-		return nil, 0, err
+	var total *int64
+	if params.WithTotal {
+		var n int64
+		if err := q.Count(&n).Error; err != nil {
+			return nil, pagination.PageInfo{}, err
+		}
+		total = &n
 	}
 
-	offset := (params.Page - 1) * params.Limit
+	pp := pagination.Params{
+		Page:   params.Page,
+		Limit:  params.Limit,
+		Cursor: params.Cursor,
+	}
 
-	err := q.
-		Order("created_at DESC").
-		Limit(params.Limit).
-		Offset(offset).
+	err := pagination.Apply(q, "tags", true, pp).
 		Find(&tags).Error
 
 	if err != nil {
-		return nil, 0, err
+		return nil, pagination.PageInfo{}, err
 	}
 
-	return tags, total, nil
+	tags, info := pagination.Trim(tags, pp)
+	info.Total = total
+
+	return tags, info, nil
 }
 
 func (r *tagRepository) GetByID(ctx context.Context, id string) (*models.Tag, error) {
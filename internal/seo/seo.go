@@ -0,0 +1,136 @@
+package seo
+
+import (
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+)
+
+// Site: info situs dari config, untuk URL absolute & author JSON-LD
+type Site struct {
+	BaseURL string
+	Author  string
+}
+
+// Head: nilai final untuk <head> (override SEO atau fallback) + JSON-LD siap inject
+type Head struct {
+	Title        string         `json:"title"`
+	Description  string         `json:"description"`
+	CanonicalURL string         `json:"canonicalUrl"`
+	Image        string         `json:"image,omitempty"`
+	JSONLD       map[string]any `json:"jsonLd"`
+}
+
+func pick(override *string, fallback string) string {
+	if override != nil && strings.TrimSpace(*override) != "" {
+		return *override
+	}
+	return fallback
+}
+
+func (s Site) absolute(u string) string {
+	if u == "" || strings.HasPrefix(u, "http://") || strings.HasPrefix(u, "https://") {
+		return u
+	}
+	return s.BaseURL + "/" + strings.TrimPrefix(u, "/")
+}
+
+func (s Site) author() map[string]any {
+	if s.Author == "" {
+		return nil
+	}
+	return map[string]any{
+		"@type": "Person",
+		"name":  s.Author,
+		"url":   s.BaseURL,
+	}
+}
+
+func keywords(tags []models.Tag) string {
+	names := make([]string, 0, len(tags))
+	for _, t := range tags {
+		names = append(names, t.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+// setIf: field kosong tidak dimasukkan ke JSON-LD
+func setIf(m map[string]any, key string, v any) {
+	switch x := v.(type) {
+	case string:
+		if x == "" {
+			return
+		}
+	case map[string]any:
+		if x == nil {
+			return
+		}
+	}
+	m[key] = v
+}
+
+// ProjectHead: project dengan repo → SoftwareSourceCode, selain itu CreativeWork
+func ProjectHead(site Site, p models.Project) Head {
+	h := Head{
+		Title:        pick(p.SEO.MetaTitle, p.Title),
+		Description:  pick(p.SEO.MetaDescription, p.ShortDesc),
+		CanonicalURL: pick(p.SEO.CanonicalURL, site.BaseURL+"/projects/"+url.PathEscape(p.Slug)),
+		Image:        site.absolute(pick(p.SEO.OGImageURL, p.CoverImageURL)),
+	}
+
+	ld := map[string]any{
+		"@context":     "https://schema.org",
+		"@type":        "CreativeWork",
+		"name":         p.Title,
+		"url":          h.CanonicalURL,
+		"dateCreated":  p.CreatedAt.UTC().Format(time.RFC3339),
+		"dateModified": p.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+	if p.RepoURL != nil && *p.RepoURL != "" {
+		ld["@type"] = "SoftwareSourceCode"
+		ld["codeRepository"] = *p.RepoURL
+	}
+	setIf(ld, "description", h.Description)
+	setIf(ld, "image", h.Image)
+	setIf(ld, "keywords", keywords(p.Tags))
+	setIf(ld, "genre", p.Category)
+	setIf(ld, "author", site.author())
+	if p.DemoURL != nil && *p.DemoURL != "" {
+		ld["sameAs"] = *p.DemoURL
+	}
+
+	h.JSONLD = ld
+	return h
+}
+
+// PostHead: BlogPosting
+func PostHead(site Site, p models.Post) Head {
+	h := Head{
+		Title:        pick(p.SEO.MetaTitle, p.Title),
+		Description:  pick(p.SEO.MetaDescription, p.Excerpt),
+		CanonicalURL: pick(p.SEO.CanonicalURL, site.BaseURL+"/posts/"+url.PathEscape(p.Slug)),
+		Image:        site.absolute(pick(p.SEO.OGImageURL, p.CoverImageURL)),
+	}
+
+	ld := map[string]any{
+		"@context":         "https://schema.org",
+		"@type":            "BlogPosting",
+		"headline":         p.Title,
+		"url":              h.CanonicalURL,
+		"mainEntityOfPage": h.CanonicalURL,
+		"dateModified":     p.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+	if p.PublishedAt != nil {
+		ld["datePublished"] = p.PublishedAt.UTC().Format(time.RFC3339)
+	}
+	setIf(ld, "description", h.Description)
+	setIf(ld, "image", h.Image)
+	setIf(ld, "keywords", keywords(p.Tags))
+	setIf(ld, "author", site.author())
+	setIf(ld, "publisher", site.author())
+
+	h.JSONLD = ld
+	return h
+}
@@ -0,0 +1,127 @@
+package sitemap
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// Batas protokol sitemaps.org per file
+const (
+	MaxURLs  = 50000
+	MaxBytes = 50 * 1024 * 1024
+
+	ContentType = "application/xml; charset=utf-8"
+	xmlns       = "http://www.sitemaps.org/schemas/sitemap/0.9"
+)
+
+type URL struct {
+	Loc     string
+	LastMod *time.Time
+}
+
+type urlSet struct {
+	XMLName xml.Name `xml:"urlset"`
+	Xmlns   string   `xml:"xmlns,attr"`
+	URLs    []urlEntry
+}
+
+type urlEntry struct {
+	XMLName xml.Name `xml:"url"`
+	Loc     string   `xml:"loc"`
+	LastMod string   `xml:"lastmod,omitempty"`
+}
+
+type index struct {
+	XMLName  xml.Name `xml:"sitemapindex"`
+	Xmlns    string   `xml:"xmlns,attr"`
+	Sitemaps []indexEntry
+}
+
+type indexEntry struct {
+	XMLName xml.Name `xml:"sitemap"`
+	Loc     string   `xml:"loc"`
+	LastMod string   `xml:"lastmod,omitempty"`
+}
+
+// overhead: header + <urlset ...></urlset>, dipakai untuk hitung ukuran per file
+var overhead = len(xml.Header) + len(`<urlset xmlns="`+xmlns+`"></urlset>`)
+
+func formatLastMod(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func entry(u URL) urlEntry {
+	return urlEntry{Loc: u.Loc, LastMod: formatLastMod(u.LastMod)}
+}
+
+// Split: pecah URL ke beberapa file sesuai batas jumlah & ukuran (maxURLs / maxBytes <= 0 = pakai batas protokol).
+// Selalu mengembalikan minimal satu chunk (boleh kosong).
+func Split(urls []URL, maxURLs, maxBytes int) ([][]URL, error) {
+	if maxURLs <= 0 || maxURLs > MaxURLs {
+		maxURLs = MaxURLs
+	}
+	if maxBytes <= 0 || maxBytes > MaxBytes {
+		maxBytes = MaxBytes
+	}
+
+	chunks := [][]URL{{}}
+	size := overhead
+
+	for _, u := range urls {
+		b, err := xml.Marshal(entry(u))
+		if err != nil {
+			return nil, err
+		}
+
+		cur := chunks[len(chunks)-1]
+		if len(cur) > 0 && (len(cur) >= maxURLs || size+len(b) > maxBytes) {
+			chunks = append(chunks, []URL{})
+			size = overhead
+		}
+
+		chunks[len(chunks)-1] = append(chunks[len(chunks)-1], u)
+		size += len(b)
+	}
+
+	return chunks, nil
+}
+
+// URLSet: render satu file <urlset>
+func URLSet(urls []URL) ([]byte, error) {
+	doc := urlSet{Xmlns: xmlns, URLs: make([]urlEntry, 0, len(urls))}
+	for _, u := range urls {
+		doc.URLs = append(doc.URLs, entry(u))
+	}
+	return marshal(doc)
+}
+
+// Index: render <sitemapindex>; Loc tiap URL = lokasi file sitemap anak
+func Index(sitemaps []URL) ([]byte, error) {
+	doc := index{Xmlns: xmlns, Sitemaps: make([]indexEntry, 0, len(sitemaps))}
+	for _, s := range sitemaps {
+		doc.Sitemaps = append(doc.Sitemaps, indexEntry{Loc: s.Loc, LastMod: formatLastMod(s.LastMod)})
+	}
+	return marshal(doc)
+}
+
+// LatestMod: lastmod terbaru dalam satu chunk (untuk entry index)
+func LatestMod(urls []URL) *time.Time {
+	var latest *time.Time
+	for _, u := range urls {
+		if u.LastMod != nil && (latest == nil || u.LastMod.After(*latest)) {
+			latest = u.LastMod
+		}
+	}
+	return latest
+}
+
+func marshal(v any) ([]byte, error) {
+	b, err := xml.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), b...), nil
+}
@@ -0,0 +1,165 @@
+package skills
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/FauzanParanditha/portfolio-backend/internal/tenure"
+	"github.com/google/uuid"
+)
+
+// Skill: satu tag dengan statistik pemakaian dari experience & project
+type Skill struct {
+	TagID           string  `json:"tagId"`
+	Name            string  `json:"name"`
+	Type            string  `json:"type"`
+	Years           float64 `json:"years"`  // dibulatkan 1 desimal
+	Months          int     `json:"months"` // total bulan, periode overlap dihitung sekali
+	ExperienceCount int     `json:"experienceCount"`
+	ProjectCount    int     `json:"projectCount"`
+	LastUsed        *string `json:"lastUsed"` // "2006-01-02"
+	IsCurrent       bool    `json:"isCurrent"`
+	Proficiency     *string `json:"proficiency"`
+	SortOrder       *int    `json:"sortOrder"`
+}
+
+// Group: skill per Tag.Type
+type Group struct {
+	Type   string  `json:"type"`
+	Skills []Skill `json:"skills"`
+}
+
+// Sources: data mentah dari SkillRepository
+type Sources struct {
+	Tags      []models.Tag
+	Periods   []models.SkillPeriod
+	Projects  []models.SkillProjectStat
+	Overrides []models.SkillOverride
+}
+
+// Build: hitung matrix. Tag tanpa experience & project tidak ikut, kecuali punya override;
+// override Hidden selalu dibuang.
+func Build(src Sources, now time.Time) []Group {
+	type acc struct {
+		spans     []tenure.Span
+		exps      int
+		projects  int
+		lastUsed  time.Time
+		isCurrent bool
+	}
+
+	stats := map[uuid.UUID]*acc{}
+	get := func(id uuid.UUID) *acc {
+		a, ok := stats[id]
+		if !ok {
+			a = &acc{}
+			stats[id] = a
+		}
+		return a
+	}
+
+	for _, p := range src.Periods {
+		a := get(p.TagID)
+
+		var end *time.Time
+		if !p.IsCurrent {
+			end = p.EndDate
+		}
+		a.spans = append(a.spans, tenure.NewSpan(p.StartDate, end, now))
+		a.exps++
+
+		used := now
+		if end != nil {
+			used = *end
+		}
+		if p.IsCurrent || p.EndDate == nil {
+			a.isCurrent = true
+		}
+		if used.After(a.lastUsed) {
+			a.lastUsed = used
+		}
+	}
+
+	for _, p := range src.Projects {
+		a := get(p.TagID)
+		a.projects += p.ProjectCount
+		if p.LastProjectAt.After(a.lastUsed) {
+			a.lastUsed = p.LastProjectAt
+		}
+	}
+
+	overrides := map[uuid.UUID]models.SkillOverride{}
+	for _, o := range src.Overrides {
+		overrides[o.TagID] = o
+	}
+
+	byType := map[string][]Skill{}
+	for _, t := range src.Tags {
+		o, hasOverride := overrides[t.ID]
+		if hasOverride && o.Hidden {
+			continue
+		}
+
+		a, used := stats[t.ID]
+		if !used && !hasOverride {
+			continue
+		}
+		if a == nil {
+			a = &acc{}
+		}
+
+		months := tenure.TotalMonths(a.spans)
+		s := Skill{
+			TagID:           t.ID.String(),
+			Name:            t.Name,
+			Type:            t.Type,
+			Years:           math.Round(float64(months)/12*10) / 10,
+			Months:          months,
+			ExperienceCount: a.exps,
+			ProjectCount:    a.projects,
+			IsCurrent:       a.isCurrent,
+		}
+		if !a.lastUsed.IsZero() {
+			d := a.lastUsed.Format("2006-01-02")
+			s.LastUsed = &d
+		}
+		if hasOverride {
+			s.Proficiency = o.Proficiency
+			s.SortOrder = o.SortOrder
+		}
+
+		byType[t.Type] = append(byType[t.Type], s)
+	}
+
+	groups := make([]Group, 0, len(byType))
+	for typ, list := range byType {
+		sort.SliceStable(list, func(i, j int) bool { return less(list[i], list[j]) })
+		groups = append(groups, Group{Type: typ, Skills: list})
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].Type < groups[j].Type })
+
+	return groups
+}
+
+// less: sortOrder manual dulu (kecil di atas), lalu pemakaian terlama, project terbanyak, nama
+func less(a, b Skill) bool {
+	switch {
+	case a.SortOrder != nil && b.SortOrder == nil:
+		return true
+	case a.SortOrder == nil && b.SortOrder != nil:
+		return false
+	case a.SortOrder != nil && *a.SortOrder != *b.SortOrder:
+		return *a.SortOrder < *b.SortOrder
+	}
+
+	if a.Months != b.Months {
+		return a.Months > b.Months
+	}
+	if a.ProjectCount != b.ProjectCount {
+		return a.ProjectCount > b.ProjectCount
+	}
+	return strings.ToLower(a.Name) < strings.ToLower(b.Name)
+}
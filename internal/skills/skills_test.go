@@ -0,0 +1,167 @@
+package skills
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/FauzanParanditha/portfolio-backend/internal/models"
+	"github.com/google/uuid"
+)
+
+func date(year int, m time.Month, day int) time.Time {
+	return time.Date(year, m, day, 0, 0, 0, 0, time.UTC)
+}
+
+func ptr[T any](v T) *T { return &v }
+
+func TestBuild(t *testing.T) {
+	now := date(2026, time.October, 19)
+
+	tag := func(name, typ string) models.Tag {
+		return models.Tag{ID: uuid.New(), Name: name, Type: typ}
+	}
+	goTag := tag("Go", "language")
+	ts := tag("TypeScript", "language")
+	elixir := tag("elixir", "language")
+	zig := tag("Zig", "language")
+	python := tag("Python", "language")
+	rust := tag("Rust", "language")
+	pg := tag("PostgreSQL", "database")
+	docker := tag("Docker", "tool")
+
+	src := Sources{
+		Tags: []models.Tag{goTag, ts, elixir, zig, python, rust, pg, docker},
+		Periods: []models.SkillPeriod{
+			// Go: Jan 2024–Dec 2024 + Jun 2024–sekarang, overlap dihitung sekali
+			{TagID: goTag.ID, StartDate: date(2024, time.January, 1), EndDate: ptr(date(2024, time.December, 31))},
+			{TagID: goTag.ID, StartDate: date(2024, time.June, 1), IsCurrent: true},
+			{TagID: pg.ID, StartDate: date(2022, time.January, 1), EndDate: ptr(date(2022, time.June, 30))},
+			{TagID: python.ID, StartDate: date(2018, time.January, 1), EndDate: ptr(date(2023, time.January, 1))},
+		},
+		Projects: []models.SkillProjectStat{
+			{TagID: goTag.ID, ProjectCount: 4, LastProjectAt: date(2025, time.March, 1)},
+			{TagID: pg.ID, ProjectCount: 2, LastProjectAt: date(2025, time.May, 2)},
+			{TagID: ts.ID, ProjectCount: 1, LastProjectAt: date(2023, time.July, 3)},
+			{TagID: zig.ID, ProjectCount: 1, LastProjectAt: date(2021, time.January, 1)},
+			{TagID: elixir.ID, ProjectCount: 1, LastProjectAt: date(2021, time.January, 1)},
+		},
+		Overrides: []models.SkillOverride{
+			{TagID: ts.ID, SortOrder: ptr(0), Proficiency: ptr("expert")},
+			{TagID: python.ID, Hidden: true},
+			{TagID: docker.ID, Proficiency: ptr("familiar")},
+		},
+	}
+
+	groups := Build(src, now)
+
+	names := map[string][]string{}
+	var types []string
+	for _, g := range groups {
+		types = append(types, g.Type)
+		for _, s := range g.Skills {
+			names[g.Type] = append(names[g.Type], s.Name)
+		}
+	}
+
+	if want := []string{"database", "language", "tool"}; !reflect.DeepEqual(types, want) {
+		t.Fatalf("group order = %v, want %v", types, want)
+	}
+
+	// TypeScript punya sortOrder manual, lalu bulan terbanyak, lalu nama (case-insensitive);
+	// Python disembunyikan override, Rust tidak dipakai & tanpa override
+	wantNames := map[string][]string{
+		"database": {"PostgreSQL"},
+		"language": {"TypeScript", "Go", "elixir", "Zig"},
+		"tool":     {"Docker"},
+	}
+	if !reflect.DeepEqual(names, wantNames) {
+		t.Fatalf("skills = %v, want %v", names, wantNames)
+	}
+
+	skill := func(typ, name string) Skill {
+		for _, g := range groups {
+			for _, s := range g.Skills {
+				if g.Type == typ && s.Name == name {
+					return s
+				}
+			}
+		}
+		t.Fatalf("skill %s/%s not found", typ, name)
+		return Skill{}
+	}
+
+	tests := []struct {
+		name string
+		got  Skill
+		want Skill
+	}{
+		{
+			name: "overlapping periods merged, current",
+			got:  skill("language", "Go"),
+			want: Skill{
+				TagID: goTag.ID.String(), Name: "Go", Type: "language",
+				Months: 34, Years: 2.8, ExperienceCount: 2, ProjectCount: 4,
+				LastUsed: ptr("2026-10-19"), IsCurrent: true,
+			},
+		},
+		{
+			name: "last used from project after experience ended",
+			got:  skill("database", "PostgreSQL"),
+			want: Skill{
+				TagID: pg.ID.String(), Name: "PostgreSQL", Type: "database",
+				Months: 6, Years: 0.5, ExperienceCount: 1, ProjectCount: 2,
+				LastUsed: ptr("2025-05-02"),
+			},
+		},
+		{
+			name: "override fields applied",
+			got:  skill("language", "TypeScript"),
+			want: Skill{
+				TagID: ts.ID.String(), Name: "TypeScript", Type: "language",
+				ProjectCount: 1, LastUsed: ptr("2023-07-03"),
+				Proficiency: ptr("expert"), SortOrder: ptr(0),
+			},
+		},
+		{
+			name: "unused tag kept because of override",
+			got:  skill("tool", "Docker"),
+			want: Skill{
+				TagID: docker.ID.String(), Name: "Docker", Type: "tool",
+				Proficiency: ptr("familiar"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.got, tt.want) {
+				t.Fatalf("got %+v, want %+v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLess(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Skill
+		want bool
+	}{
+		{"manual order before automatic", Skill{SortOrder: ptr(5)}, Skill{Months: 100}, true},
+		{"automatic after manual", Skill{Months: 100}, Skill{SortOrder: ptr(5)}, false},
+		{"lower manual order first", Skill{SortOrder: ptr(1)}, Skill{SortOrder: ptr(2)}, true},
+		{"equal manual order falls back to months", Skill{SortOrder: ptr(1), Months: 3}, Skill{SortOrder: ptr(1), Months: 12}, false},
+		{"more months first", Skill{Months: 12}, Skill{Months: 3}, true},
+		{"more projects first", Skill{ProjectCount: 3}, Skill{ProjectCount: 1}, true},
+		{"name case-insensitive", Skill{Name: "apple"}, Skill{Name: "Banana"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := less(tt.a, tt.b); got != tt.want {
+				t.Fatalf("less(%+v, %+v) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package tenure
+
+import (
+	"sort"
+	"time"
+)
+
+// Span: rentang bulan inklusif (index = tahun*12 + bulan-1)
+type Span struct {
+	From, To int
+}
+
+func MonthIndex(t time.Time) int {
+	return t.Year()*12 + int(t.Month()) - 1
+}
+
+// NewSpan: end nil = masih berjalan (now). end < start dianggap 1 bulan.
+func NewSpan(start time.Time, end *time.Time, now time.Time) Span {
+	to := now
+	if end != nil {
+		to = *end
+	}
+	s := Span{From: MonthIndex(start), To: MonthIndex(to)}
+	if s.To < s.From {
+		s.To = s.From
+	}
+	return s
+}
+
+// TotalMonths: jumlah bulan dari gabungan span; bulan yang overlap hanya dihitung sekali
+func TotalMonths(spans []Span) int {
+	if len(spans) == 0 {
+		return 0
+	}
+
+	sorted := append([]Span(nil), spans...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].From < sorted[j].From })
+
+	total := 0
+	cur := sorted[0]
+	for _, s := range sorted[1:] {
+		if s.From <= cur.To+1 {
+			if s.To > cur.To {
+				cur.To = s.To
+			}
+			continue
+		}
+		total += cur.To - cur.From + 1
+		cur = s
+	}
+	total += cur.To - cur.From + 1
+
+	return total
+}
@@ -0,0 +1,74 @@
+package tenure
+
+import (
+	"testing"
+	"time"
+)
+
+func month(year int, m time.Month) time.Time {
+	return time.Date(year, m, 15, 0, 0, 0, 0, time.UTC)
+}
+
+func TestTotalMonths(t *testing.T) {
+	// Jan 2020 = index 2020*12
+	jan20 := MonthIndex(month(2020, time.January))
+
+	tests := []struct {
+		name  string
+		spans []Span
+		want  int
+	}{
+		{"empty", nil, 0},
+		{"single month", []Span{{jan20, jan20}}, 1},
+		{"one year", []Span{{jan20, jan20 + 11}}, 12},
+		{"disjoint", []Span{{jan20, jan20 + 2}, {jan20 + 6, jan20 + 8}}, 6},
+		{"overlap counted once", []Span{{jan20, jan20 + 5}, {jan20 + 3, jan20 + 8}}, 9},
+		{"contained", []Span{{jan20, jan20 + 11}, {jan20 + 2, jan20 + 4}}, 12},
+		{"adjacent", []Span{{jan20, jan20 + 2}, {jan20 + 3, jan20 + 5}}, 6},
+		{"unsorted input", []Span{{jan20 + 6, jan20 + 8}, {jan20, jan20 + 2}, {jan20 + 1, jan20 + 7}}, 9},
+		{"identical", []Span{{jan20, jan20 + 3}, {jan20, jan20 + 3}}, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TotalMonths(tt.spans); got != tt.want {
+				t.Fatalf("TotalMonths(%v) = %d, want %d", tt.spans, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTotalMonthsDoesNotReorderInput(t *testing.T) {
+	spans := []Span{{10, 12}, {1, 3}}
+	TotalMonths(spans)
+
+	if spans[0] != (Span{10, 12}) || spans[1] != (Span{1, 3}) {
+		t.Fatalf("input modified: %v", spans)
+	}
+}
+
+func TestNewSpan(t *testing.T) {
+	now := month(2026, time.October)
+	end := month(2021, time.June)
+	before := month(2019, time.March)
+
+	tests := []struct {
+		name  string
+		start time.Time
+		end   *time.Time
+		want  int // jumlah bulan
+	}{
+		{"ended", month(2021, time.January), &end, 6},
+		{"ongoing uses now", month(2026, time.January), nil, 10},
+		{"end before start is one month", month(2020, time.January), &before, 1},
+		{"same month", month(2021, time.June), &end, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TotalMonths([]Span{NewSpan(tt.start, tt.end, now)}); got != tt.want {
+				t.Fatalf("months = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
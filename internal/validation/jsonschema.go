@@ -0,0 +1,105 @@
+package validation
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+var missingPropRe = regexp.MustCompile(`'((?:[^'\\]|\\.)*)'`)
+
+// URL tetap untuk schema yang di-compile; bukan file:// supaya $ref relatif
+// tidak di-resolve ke working directory server
+const schemaURL = "urn:project-schema"
+
+// CompileJSONSchema memastikan dokumen schema valid (dipakai saat admin simpan schema)
+func CompileJSONSchema(schema []byte) (*jsonschema.Schema, error) {
+	c := jsonschema.NewCompiler()
+	c.Draft = jsonschema.Draft2020
+
+	// schema datang dari admin: hanya $ref di dalam dokumen yang boleh,
+	// jangan pernah baca file lokal / URL luar
+	c.LoadURL = func(s string) (io.ReadCloser, error) {
+		return nil, fmt.Errorf("external $ref not allowed: %s", s)
+	}
+
+	if err := c.AddResource(schemaURL, bytes.NewReader(schema)); err != nil {
+		return nil, err
+	}
+
+	return c.Compile(schemaURL)
+}
+
+// ValidateJSONSchema validasi doc terhadap schema, hasilnya map[field]message
+// dengan prefix field, misal "technicalDetails.stack.0".
+func ValidateJSONSchema(schema []byte, doc any, field string) (map[string]string, error) {
+	sch, err := CompileJSONSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	return ValidateCompiled(sch, doc, field)
+}
+
+// ValidateCompiled: sama seperti ValidateJSONSchema dengan schema yang sudah di-compile
+func ValidateCompiled(sch *jsonschema.Schema, doc any, field string) (map[string]string, error) {
+	res := map[string]string{}
+
+	err := sch.Validate(doc)
+	if err == nil {
+		return res, nil
+	}
+
+	ve, ok := err.(*jsonschema.ValidationError)
+	if !ok {
+		return nil, err
+	}
+
+	collectSchemaErrors(ve, field, res)
+	return res, nil
+}
+
+// ambil leaf error saja, karena parent cuma ringkasan ("doesn't validate with ...")
+func collectSchemaErrors(ve *jsonschema.ValidationError, field string, res map[string]string) {
+	if len(ve.Causes) > 0 {
+		for _, cause := range ve.Causes {
+			collectSchemaErrors(cause, field, res)
+		}
+		return
+	}
+
+	path := instancePath(field, ve.InstanceLocation)
+
+	// "required" dilaporkan di parent object, pecah jadi satu error per property
+	if strings.HasSuffix(ve.KeywordLocation, "/required") {
+		for _, m := range missingPropRe.FindAllStringSubmatch(ve.Message, -1) {
+			res[path+"."+m[1]] = "wajib diisi"
+		}
+		return
+	}
+
+	if _, exists := res[path]; !exists {
+		res[path] = ve.Message
+	}
+}
+
+// "/stack/0" → "technicalDetails.stack.0"
+func instancePath(field, location string) string {
+	location = strings.Trim(location, "/")
+	if location == "" {
+		return field
+	}
+
+	parts := strings.Split(location, "/")
+	for i, p := range parts {
+		// unescape JSON pointer
+		p = strings.ReplaceAll(p, "~1", "/")
+		parts[i] = strings.ReplaceAll(p, "~0", "~")
+	}
+
+	return field + "." + strings.Join(parts, ".")
+}
@@ -0,0 +1,65 @@
+package validation
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+const dateLayout = "2006-01-02"
+
+// date_gtefield=StartDate: tanggal "2006-01-02" (string / *string) tidak boleh
+// sebelum field lain di struct yang sama. Kosong atau format salah dilewati,
+// format dicek oleh tag datetime.
+func dateGteField(fl validator.FieldLevel) bool {
+	s, ok := stringValue(fl.Field())
+	if !ok || s == "" {
+		return true
+	}
+
+	other, _, _, found := fl.GetStructFieldOKAdvanced2(fl.Parent(), fl.Param())
+	if !found {
+		return true
+	}
+	o, ok := stringValue(other)
+	if !ok || o == "" {
+		return true
+	}
+
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		return true
+	}
+	ot, err := time.Parse(dateLayout, o)
+	if err != nil {
+		return true
+	}
+
+	return !t.Before(ot)
+}
+
+// empty_if=IsCurrent: field harus nil / "" kalau field bool yang disebut bernilai true
+func emptyIf(fl validator.FieldLevel) bool {
+	other, kind, _, found := fl.GetStructFieldOKAdvanced2(fl.Parent(), fl.Param())
+	if !found || kind != reflect.Bool || !other.Bool() {
+		return true
+	}
+
+	s, ok := stringValue(fl.Field())
+	return !ok || s == ""
+}
+
+// stringValue: isi string dari string / *string; ok = false kalau nil atau bukan string
+func stringValue(v reflect.Value) (string, bool) {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return "", false
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.String {
+		return "", false
+	}
+	return v.String(), true
+}
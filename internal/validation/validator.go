@@ -1,10 +1,23 @@
 package validation
 
 import (
+	"reflect"
+	"strings"
+
 	"github.com/go-playground/validator/v10"
 )
 
-var validate = validator.New()
+var validate = newValidator()
+
+func newValidator() *validator.Validate {
+	v := validator.New()
+
+	// rule lintas field (lihat rules.go)
+	v.RegisterValidation("date_gtefield", dateGteField, true)
+	v.RegisterValidation("empty_if", emptyIf, true)
+
+	return v
+}
 
 func ValidateStruct(s any) error {
 	return validate.Struct(s)
@@ -21,10 +34,30 @@ func ToFieldErrors(err error) map[string]string {
 			switch fe.Tag() {
 			case "required":
 				res[field] = "wajib diisi"
+			case "required_unless":
+				res[field] = "wajib diisi kecuali " + conditionText(fe.Param())
 			case "email":
 				res[field] = "format email tidak valid"
 			case "url":
 				res[field] = "format URL tidak valid"
+			case "datetime":
+				res[field] = "format tanggal tidak valid (" + fe.Param() + ")"
+			case "max":
+				res[field] = "maksimal " + fe.Param() + " karakter"
+			case "min":
+				if fe.Kind() == reflect.Slice {
+					res[field] = "minimal " + fe.Param() + " item"
+				} else {
+					res[field] = "minimal " + fe.Param() + " karakter"
+				}
+			case "required_without":
+				res[field] = "wajib diisi jika " + fe.Param() + " kosong"
+			case "oneof":
+				res[field] = "harus salah satu dari: " + strings.ReplaceAll(fe.Param(), " ", ", ")
+			case "date_gtefield":
+				res[field] = "tidak boleh sebelum " + fe.Param()
+			case "empty_if":
+				res[field] = "harus kosong jika " + fe.Param() + " true"
 			default:
 				res[field] = "tidak valid"
 			}
@@ -33,3 +66,8 @@ func ToFieldErrors(err error) map[string]string {
 
 	return res
 }
+
+// "IsCurrent true" → "IsCurrent = true"
+func conditionText(param string) string {
+	return strings.Join(strings.Fields(param), " = ")
+}
@@ -0,0 +1,17 @@
+-- Hasil link checker untuk URL demo/repo/cover/screenshot project
+CREATE TABLE IF NOT EXISTS link_checks (
+    id          uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    project_id  uuid NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    kind        varchar(20) NOT NULL,
+    url         text NOT NULL,
+    status_code int NOT NULL DEFAULT 0,
+    is_broken   boolean NOT NULL DEFAULT false,
+    error       text NOT NULL DEFAULT '',
+    latency_ms  bigint NOT NULL DEFAULT 0,
+    checked_at  timestamptz NOT NULL DEFAULT now(),
+    created_at  timestamptz NOT NULL DEFAULT now(),
+    updated_at  timestamptz NOT NULL DEFAULT now(),
+    UNIQUE (project_id, kind, url)
+);
+
+CREATE INDEX IF NOT EXISTS idx_link_checks_is_broken ON link_checks (is_broken);
@@ -0,0 +1,9 @@
+-- JSON Schema per kategori project untuk validasi technical_details
+CREATE TABLE IF NOT EXISTS project_schemas (
+    id          uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    category    varchar(150) NOT NULL UNIQUE,
+    description text NOT NULL DEFAULT '',
+    schema      jsonb NOT NULL,
+    created_at  timestamptz NOT NULL DEFAULT now(),
+    updated_at  timestamptz NOT NULL DEFAULT now()
+);
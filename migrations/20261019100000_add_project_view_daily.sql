@@ -0,0 +1,12 @@
+-- Rollup harian view project (tanpa cookie / IP mentah)
+CREATE TABLE IF NOT EXISTS project_view_daily (
+    project_id    uuid NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    day           date NOT NULL,
+    referrer_host varchar(255) NOT NULL DEFAULT 'direct',
+    ua_class      varchar(20) NOT NULL DEFAULT 'other',
+    views         bigint NOT NULL DEFAULT 0,
+    uniques       bigint NOT NULL DEFAULT 0,
+    PRIMARY KEY (project_id, day, referrer_host, ua_class)
+);
+
+CREATE INDEX IF NOT EXISTS idx_project_view_daily_day ON project_view_daily (day);
@@ -0,0 +1,23 @@
+-- Blog / writing: posts (markdown) + tag (reuse tabel tags)
+CREATE TABLE IF NOT EXISTS posts (
+    id              uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    title           varchar(255) NOT NULL,
+    slug            varchar(255) NOT NULL UNIQUE,
+    excerpt         text NOT NULL DEFAULT '',
+    body            text NOT NULL DEFAULT '',
+    cover_image_url text NOT NULL DEFAULT '',
+    status          varchar(20) NOT NULL DEFAULT 'draft',
+    published_at    timestamptz,
+    reading_time    int NOT NULL DEFAULT 1,
+    created_at      timestamptz NOT NULL DEFAULT now(),
+    updated_at      timestamptz NOT NULL DEFAULT now(),
+    CONSTRAINT posts_status_check CHECK (status IN ('draft', 'published'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_posts_published ON posts (published_at DESC, id DESC) WHERE status = 'published';
+
+CREATE TABLE IF NOT EXISTS post_tags (
+    post_id uuid NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+    tag_id  uuid NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    PRIMARY KEY (post_id, tag_id)
+);
@@ -0,0 +1,12 @@
+-- Override SEO (meta title/description, canonical, og:image); NULL = fallback ke field tampilan
+ALTER TABLE projects
+    ADD COLUMN IF NOT EXISTS meta_title       varchar(255),
+    ADD COLUMN IF NOT EXISTS meta_description text,
+    ADD COLUMN IF NOT EXISTS canonical_url    text,
+    ADD COLUMN IF NOT EXISTS og_image_url     text;
+
+ALTER TABLE posts
+    ADD COLUMN IF NOT EXISTS meta_title       varchar(255),
+    ADD COLUMN IF NOT EXISTS meta_description text,
+    ADD COLUMN IF NOT EXISTS canonical_url    text,
+    ADD COLUMN IF NOT EXISTS og_image_url     text;
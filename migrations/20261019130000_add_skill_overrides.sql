@@ -0,0 +1,9 @@
+-- Override manual untuk skills matrix (per tag): level proficiency, urutan tampil, sembunyikan
+CREATE TABLE IF NOT EXISTS skill_overrides (
+    tag_id      uuid PRIMARY KEY REFERENCES tags(id) ON DELETE CASCADE,
+    proficiency varchar(20),
+    sort_order  int,
+    hidden      boolean NOT NULL DEFAULT false,
+    updated_at  timestamptz NOT NULL DEFAULT now(),
+    CONSTRAINT skill_overrides_proficiency_check CHECK (proficiency IS NULL OR proficiency IN ('beginner', 'intermediate', 'advanced', 'expert'))
+);
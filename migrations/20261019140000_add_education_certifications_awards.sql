@@ -0,0 +1,61 @@
+-- Pendidikan, sertifikasi & penghargaan (sebelumnya dijejalkan ke experiences)
+CREATE TABLE IF NOT EXISTS educations (
+    id             uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    institution    varchar(255) NOT NULL,
+    degree         varchar(255) NOT NULL DEFAULT '',
+    field_of_study varchar(255) NOT NULL DEFAULT '',
+    location       varchar(255) NOT NULL DEFAULT '',
+    start_date     date NOT NULL,
+    end_date       date,
+    is_current     boolean NOT NULL DEFAULT false,
+    grade          varchar(100) NOT NULL DEFAULT '',
+    description    text NOT NULL DEFAULT '',
+    sort_order     int NOT NULL DEFAULT 0,
+    created_at     timestamptz NOT NULL DEFAULT now(),
+    updated_at     timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS education_tags (
+    education_id uuid NOT NULL REFERENCES educations(id) ON DELETE CASCADE,
+    tag_id       uuid NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    PRIMARY KEY (education_id, tag_id)
+);
+
+CREATE TABLE IF NOT EXISTS certifications (
+    id             uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name           varchar(255) NOT NULL,
+    issuer         varchar(255) NOT NULL,
+    issue_date     date NOT NULL,
+    expiry_date    date, -- NULL = tidak kedaluwarsa
+    credential_id  varchar(255) NOT NULL DEFAULT '',
+    credential_url text NOT NULL DEFAULT '',
+    description    text NOT NULL DEFAULT '',
+    sort_order     int NOT NULL DEFAULT 0,
+    created_at     timestamptz NOT NULL DEFAULT now(),
+    updated_at     timestamptz NOT NULL DEFAULT now(),
+    CONSTRAINT certifications_expiry_check CHECK (expiry_date IS NULL OR expiry_date >= issue_date)
+);
+
+CREATE TABLE IF NOT EXISTS certification_tags (
+    certification_id uuid NOT NULL REFERENCES certifications(id) ON DELETE CASCADE,
+    tag_id           uuid NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    PRIMARY KEY (certification_id, tag_id)
+);
+
+CREATE TABLE IF NOT EXISTS awards (
+    id          uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    title       varchar(255) NOT NULL,
+    issuer      varchar(255) NOT NULL DEFAULT '',
+    award_date  date NOT NULL,
+    url         text NOT NULL DEFAULT '',
+    description text NOT NULL DEFAULT '',
+    sort_order  int NOT NULL DEFAULT 0,
+    created_at  timestamptz NOT NULL DEFAULT now(),
+    updated_at  timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS award_tags (
+    award_id uuid NOT NULL REFERENCES awards(id) ON DELETE CASCADE,
+    tag_id   uuid NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    PRIMARY KEY (award_id, tag_id)
+);
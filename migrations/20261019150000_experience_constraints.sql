@@ -0,0 +1,17 @@
+-- Aturan isCurrent / endDate: role aktif ⇔ end_date NULL.
+-- NOT VALID: data lama yang bentrok tidak diubah (muncul di content health,
+-- dirapikan manual lewat admin), insert / update baru tetap dicek
+ALTER TABLE experiences
+    ADD CONSTRAINT experiences_current_end_date_check CHECK (is_current = (end_date IS NULL)) NOT VALID;
+
+-- NOT VALID: baris lama dengan end_date < start_date tidak memblokir migrasi,
+-- tapi insert / update baru tetap dicek
+ALTER TABLE experiences
+    ADD CONSTRAINT experiences_date_range_check CHECK (end_date IS NULL OR end_date >= start_date) NOT VALID;
+
+-- Jenis kerja; overlap warning hanya antar role full_time
+ALTER TABLE experiences
+    ADD COLUMN IF NOT EXISTS employment_type varchar(20) NOT NULL DEFAULT 'full_time';
+
+ALTER TABLE experiences
+    ADD CONSTRAINT experiences_employment_type_check CHECK (employment_type IN ('full_time', 'part_time', 'contract', 'freelance', 'internship'));
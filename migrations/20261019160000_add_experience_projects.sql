@@ -0,0 +1,8 @@
+-- Project yang dikerjakan di sebuah experience (many-to-many)
+CREATE TABLE IF NOT EXISTS experience_projects (
+    experience_id uuid NOT NULL REFERENCES experiences(id) ON DELETE CASCADE,
+    project_id    uuid NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    PRIMARY KEY (experience_id, project_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_experience_projects_project ON experience_projects (project_id);
@@ -0,0 +1,66 @@
+-- Organisasi (employer / client) yang sebelumnya hanya teks bebas di experiences.company
+CREATE TABLE IF NOT EXISTS organizations (
+    id          uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name        varchar(255) NOT NULL,
+    slug        varchar(255) NOT NULL,
+    logo_url    text NOT NULL DEFAULT '',
+    website_url text NOT NULL DEFAULT '',
+    industry    varchar(255) NOT NULL DEFAULT '',
+    location    varchar(255) NOT NULL DEFAULT '',
+    created_at  timestamptz NOT NULL DEFAULT now(),
+    updated_at  timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_organizations_slug ON organizations (slug);
+-- nama beda kapitalisasi = organisasi yang sama
+CREATE UNIQUE INDEX IF NOT EXISTS idx_organizations_name_lower ON organizations (lower(name));
+
+ALTER TABLE experiences
+    ADD COLUMN IF NOT EXISTS organization_id uuid REFERENCES organizations(id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS idx_experiences_organization ON experiences (organization_id);
+
+-- client project
+ALTER TABLE projects
+    ADD COLUMN IF NOT EXISTS client_id uuid REFERENCES organizations(id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS idx_projects_client ON projects (client_id);
+
+-- Backfill: satu organisasi per company (trim + case-insensitive).
+-- Nama diambil dari ejaan yang paling sering dipakai, location dari yang paling sering muncul.
+-- Slug bentrok (mis. "Acme Inc" & "Acme, Inc.") diberi suffix dari hash nama, jadi tidak
+-- bisa bentrok lagi dengan slug company lain (mis. "Acme 2"). Tanpa ON CONFLICT: kalau
+-- tetap bentrok, migrasi gagal daripada diam-diam melewatkan company.
+WITH companies AS (
+    SELECT lower(btrim(company))                                                   AS key,
+           mode() WITHIN GROUP (ORDER BY btrim(company))                           AS name,
+           coalesce(mode() WITHIN GROUP (ORDER BY nullif(btrim(location), '')), '') AS location
+    FROM experiences
+    WHERE btrim(company) <> ''
+    GROUP BY lower(btrim(company))
+),
+slugged AS (
+    SELECT key,
+           name,
+           location,
+           coalesce(nullif(btrim(regexp_replace(key, '[^a-z0-9]+', '-', 'g'), '-'), ''), 'organization') AS base
+    FROM companies
+),
+numbered AS (
+    SELECT key,
+           name,
+           location,
+           base,
+           row_number() OVER (PARTITION BY base ORDER BY name) AS n
+    FROM slugged
+)
+INSERT INTO organizations (name, slug, location)
+SELECT name,
+       CASE WHEN n = 1 THEN base ELSE base || '-' || left(md5(key), 8) END,
+       location
+FROM numbered;
+
+UPDATE experiences e
+SET organization_id = o.id,
+    company         = o.name
+FROM organizations o
+WHERE e.organization_id IS NULL
+  AND lower(btrim(e.company)) = lower(o.name);
@@ -0,0 +1,6 @@
+-- Waktu perubahan terakhir per topic konten. Dibaca untuk Last-Modified & dipoll
+-- tiap instance untuk invalidasi cache, jadi tidak tergantung state proses.
+CREATE TABLE IF NOT EXISTS content_changes (
+    topic      varchar(50) PRIMARY KEY,
+    changed_at timestamptz NOT NULL DEFAULT now()
+);
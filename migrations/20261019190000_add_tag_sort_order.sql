@@ -0,0 +1,12 @@
+-- Urutan manual tag (PUT /admin/tags/order). Backfill mengikuti urutan list sebelumnya
+-- (created_at DESC, id DESC) supaya tampilan tidak berubah.
+ALTER TABLE tags
+    ADD COLUMN IF NOT EXISTS sort_order int NOT NULL DEFAULT 0;
+
+UPDATE tags t
+SET sort_order = o.pos
+FROM (
+    SELECT id, row_number() OVER (ORDER BY created_at DESC, id DESC) - 1 AS pos
+    FROM tags
+) o
+WHERE o.id = t.id;